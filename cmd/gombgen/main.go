@@ -0,0 +1,55 @@
+// Command gombgen generates a Go struct from a table metadata file (JSON, YAML or TOML).
+//
+// Usage:
+//
+//	//go:generate go run github.com/nandrechetan/gomb/cmd/gombgen -in users.json -out users_gen.go -package models
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+)
+
+func main() {
+	in := flag.String("in", "", "table metadata file (.json, .yaml, .yml or .toml)")
+	out := flag.String("out", "", "output Go file (default: stdout)")
+	pkg := flag.String("package", "models", "package name of the generated file")
+	typeName := flag.String("type", "", "struct name (default: table name in CamelCase)")
+	sqlNulls := flag.Bool("sql-nulls", false, "use sql.Null* types instead of pointers for nullable columns")
+	flag.Parse()
+
+	if err := run(*in, *out, *pkg, *typeName, *sqlNulls); err != nil {
+		fmt.Fprintln(os.Stderr, "gombgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out, pkg, typeName string, sqlNulls bool) error {
+	if in == "" {
+		return fmt.Errorf("-in is required")
+	}
+
+	var table gomb.Table
+	if err := gomb.LoadFile(in, &table); err != nil {
+		return err
+	}
+
+	options := gomb.GoStructOptions{Package: pkg, TypeName: typeName}
+	if sqlNulls {
+		options.NullStyle = gomb.SQLNulls
+	}
+
+	src, err := gomb.GenerateGoStruct(&table, options)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
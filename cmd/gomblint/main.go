@@ -0,0 +1,101 @@
+// Command gomblint reports destructive and locking changes in SQL migration files.
+//
+// It exits with status 1 when a finding reaches the -fail-on severity and with status 2 when
+// a file cannot be read or linted.
+//
+// Usage:
+//
+//	gomblint -severity drop_index=error,rename_column=off -allow drop_cascade migrations/*.sql
+//
+// A "-- gomb:allow rule, ..." comment allows rules for a single statement.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+)
+
+func main() {
+	severities := flag.String("severity", "", "comma separated rule=severity overrides (off, info, warning, error)")
+	allow := flag.String("allow", "", "comma separated rules to skip in every file")
+	failOn := flag.String("fail-on", "error", "lowest severity that fails the run")
+	listRules := flag.Bool("rules", false, "list the rules and exit")
+	flag.Parse()
+
+	if *listRules {
+		for _, rule := range gomb.LintRules() {
+			fmt.Printf("%-22s %-13s %-8s %s\n", rule.ID, rule.Class, rule.Severity, rule.Description)
+		}
+		return
+	}
+
+	failed, err := run(os.Stdout, flag.Args(), *severities, *allow, *failOn)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gomblint:", err)
+		os.Exit(2)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func run(out io.Writer, files []string, severities, allow, failOn string) (bool, error) {
+	if len(files) == 0 {
+		return false, fmt.Errorf("no migration files given")
+	}
+	threshold, err := gomb.ParseSeverity(failOn)
+	if err != nil {
+		return false, err
+	}
+
+	linter := gomb.NewLinter()
+	for _, override := range splitList(severities) {
+		rule, level, ok := strings.Cut(override, "=")
+		if !ok {
+			return false, fmt.Errorf("invalid -severity %q, expected rule=severity", override)
+		}
+		severity, err := gomb.ParseSeverity(level)
+		if err != nil {
+			return false, err
+		}
+		linter.SetSeverity(strings.TrimSpace(rule), severity)
+	}
+	linter.Allow(splitList(allow)...)
+	if errs := linter.Errors(); len(errs) > 0 {
+		return false, errs[0]
+	}
+
+	failed := false
+	for _, file := range files {
+		sql, err := os.ReadFile(file)
+		if err != nil {
+			return false, err
+		}
+		findings, err := linter.LintSQL(string(sql))
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", file, err)
+		}
+		for _, finding := range findings {
+			fmt.Fprintf(out, "%s:%d: %s\n", file, finding.Line, finding)
+			if finding.Severity.AtLeast(threshold) {
+				failed = true
+			}
+		}
+	}
+	return failed, nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
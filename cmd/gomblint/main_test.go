@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, sql string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(sql), 0o644))
+		return path
+	}
+	safe := write("001_safe.sql", "ALTER TABLE orders ADD COLUMN note text;\n")
+	locking := write("002_locking.sql", "DROP INDEX idx_orders_total;\n")
+	destructive := write("003_destructive.sql", "\nALTER TABLE orders DROP COLUMN legacy;\n")
+
+	tests := []struct {
+		name       string
+		files      []string
+		severities string
+		allow      string
+		failOn     string
+		failed     bool
+		output     string
+	}{
+		{name: "Safe", files: []string{safe}, failOn: "error"},
+		{name: "Warning Below Threshold", files: []string{locking}, failOn: "error", output: locking + ":1: warning drop_index: idx_orders_total:"},
+		{name: "Warning At Threshold", files: []string{locking}, failOn: "warning", failed: true},
+		{name: "Error", files: []string{safe, destructive}, failOn: "error", failed: true, output: destructive + ":2: error drop_column: orders.legacy:"},
+		{name: "Severity Override", files: []string{destructive}, severities: "drop_column=info", failOn: "warning"},
+		{name: "Allow", files: []string{destructive, locking}, allow: "drop_column, drop_index", failOn: "info"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			failed, err := run(&out, tt.files, tt.severities, tt.allow, tt.failOn)
+			require.NoError(t, err)
+			assert.Equal(t, tt.failed, failed)
+			if tt.output != "" {
+				assert.Contains(t, out.String(), tt.output)
+			}
+		})
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		errors := []struct {
+			name       string
+			files      []string
+			severities string
+			allow      string
+			failOn     string
+		}{
+			{name: "No Files", failOn: "error"},
+			{name: "Missing File", files: []string{filepath.Join(dir, "missing.sql")}, failOn: "error"},
+			{name: "Invalid Fail On", files: []string{safe}, failOn: "fatal"},
+			{name: "Invalid Override", files: []string{safe}, severities: "drop_column", failOn: "error"},
+			{name: "Unknown Rule", files: []string{safe}, severities: "drop_everything=off", failOn: "error"},
+			{name: "Unknown Allow", files: []string{safe}, allow: "nope", failOn: "error"},
+			{name: "Invalid SQL", files: []string{write("004_invalid.sql", "SELECT 'unterminated")}, failOn: "error"},
+		}
+		for _, tt := range errors {
+			failed, err := run(&bytes.Buffer{}, tt.files, tt.severities, tt.allow, tt.failOn)
+			assert.Error(t, err, tt.name)
+			assert.False(t, failed, tt.name)
+		}
+	})
+}
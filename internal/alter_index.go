@@ -0,0 +1,205 @@
+package gomb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AlterIndex represents an ALTER INDEX statement (PostgreSQL). Each statement carries a single
+// action; RenameIndex and SetIndexTablespace remain for the two most common ones
+type AlterIndex struct {
+	name            string
+	schema          string
+	ifExists        bool
+	nowait          bool
+	allInTablespace string
+	ownedBy         []string
+	actions         []string
+	errs            []error
+}
+
+// NewAlterIndex creates a new alter index builder
+func NewAlterIndex(name string) *AlterIndex {
+	return &AlterIndex{name: name}
+}
+
+// NewAlterIndexesInTablespace creates a builder moving every index in the tablespace, to be
+// combined with SetTablespace and optionally OwnedBy
+func NewAlterIndexesInTablespace(tablespace string) *AlterIndex {
+	return &AlterIndex{allInTablespace: tablespace}
+}
+
+// SetSchema sets the schema for the index
+func (ai *AlterIndex) SetSchema(schema string) *AlterIndex {
+	ai.schema = schema
+	return ai
+}
+
+// SetIfExists adds IF EXISTS to the statement; ATTACH PARTITION and DEPENDS ON EXTENSION do
+// not take it
+func (ai *AlterIndex) SetIfExists() *AlterIndex {
+	ai.ifExists = true
+	return ai
+}
+
+// SetNowait adds NOWAIT to an ALL IN TABLESPACE move, failing instead of waiting for locks
+func (ai *AlterIndex) SetNowait() *AlterIndex {
+	ai.nowait = true
+	return ai
+}
+
+// OwnedBy restricts a tablespace move to indexes owned by the roles
+func (ai *AlterIndex) OwnedBy(roles ...string) *AlterIndex {
+	ai.ownedBy = append(ai.ownedBy, roles...)
+	return ai
+}
+
+// RenameTo renames the index
+func (ai *AlterIndex) RenameTo(name string) *AlterIndex {
+	return ai.action("RENAME TO " + name)
+}
+
+// SetTablespace moves the index to the tablespace
+func (ai *AlterIndex) SetTablespace(tablespace string) *AlterIndex {
+	return ai.action("SET TABLESPACE " + tablespace)
+}
+
+// SetStorageParameters sets storage parameters, e.g. "fillfactor=70"
+func (ai *AlterIndex) SetStorageParameters(parameters ...string) *AlterIndex {
+	if len(parameters) == 0 {
+		ai.errs = append(ai.errs, ErrRequired.at("", "", "parameters", "at least one storage parameter is required"))
+	}
+	return ai.action("SET (" + strings.Join(parameters, ", ") + ")")
+}
+
+// ResetStorageParameters resets storage parameters to their defaults
+func (ai *AlterIndex) ResetStorageParameters(parameters ...string) *AlterIndex {
+	if len(parameters) == 0 {
+		ai.errs = append(ai.errs, ErrRequired.at("", "", "parameters", "at least one storage parameter is required"))
+	}
+	return ai.action("RESET (" + strings.Join(parameters, ", ") + ")")
+}
+
+// AttachPartition attaches the index of a partition to this partitioned index
+func (ai *AlterIndex) AttachPartition(index string) *AlterIndex {
+	return ai.action("ATTACH PARTITION " + index)
+}
+
+// SetStatistics sets the statistics target of an expression column, numbered from 1
+func (ai *AlterIndex) SetStatistics(column, target int) *AlterIndex {
+	if column < 1 {
+		ai.errs = append(ai.errs, ErrInvalidOption.at("", "", "column", fmt.Sprintf("index column number must be positive, got %d", column)))
+	}
+	if target < -1 || target > 10000 {
+		ai.errs = append(ai.errs, ErrInvalidOption.at("", "", "statistics", fmt.Sprintf("statistics target must be between -1 and 10000, got %d", target)))
+	}
+	return ai.action(fmt.Sprintf("ALTER COLUMN %d SET STATISTICS %d", column, target))
+}
+
+// DependsOnExtension marks the index as dependent on the extension, dropping it with the extension
+func (ai *AlterIndex) DependsOnExtension(extension string) *AlterIndex {
+	return ai.action("DEPENDS ON EXTENSION " + extension)
+}
+
+// NoDependsOnExtension removes the dependency on the extension
+func (ai *AlterIndex) NoDependsOnExtension(extension string) *AlterIndex {
+	return ai.action("NO DEPENDS ON EXTENSION " + extension)
+}
+
+func (ai *AlterIndex) action(clause string) *AlterIndex {
+	ai.actions = append(ai.actions, clause)
+	return ai
+}
+
+// ToSQL generates the ALTER INDEX statement
+func (ai *AlterIndex) ToSQL() (string, error) {
+	if err := ai.validate(); err != nil {
+		return "", err
+	}
+
+	var sql strings.Builder
+	sql.WriteString("ALTER INDEX ")
+
+	if ai.allInTablespace != "" {
+		sql.WriteString("ALL IN TABLESPACE " + ai.allInTablespace)
+		if len(ai.ownedBy) > 0 {
+			sql.WriteString(" OWNED BY " + strings.Join(ai.ownedBy, ", "))
+		}
+	} else {
+		if ai.ifExists {
+			sql.WriteString("IF EXISTS ")
+		}
+		sql.WriteString(qualify(ai.schema, ai.name))
+	}
+
+	sql.WriteString(" " + ai.actions[0])
+	if ai.nowait {
+		sql.WriteString(" NOWAIT")
+	}
+	return sql.String(), nil
+}
+
+func (ai *AlterIndex) validate() error {
+	if len(ai.errs) > 0 {
+		return ai.errs[0]
+	}
+	switch {
+	case ai.name == "" && ai.allInTablespace == "":
+		return ErrRequired.at("", "", "name", "index name is required")
+	case len(ai.actions) == 0:
+		return ErrRequired.at("", "", "operations", "alter index must have an action")
+	case len(ai.actions) > 1:
+		return ErrInvalidOption.at("", "", "operations", "alter index takes a single action per statement")
+	case ai.nowait && ai.allInTablespace == "":
+		return ErrInvalidOption.at("", "", "nowait", "NOWAIT applies to ALL IN TABLESPACE only")
+	case ai.ifExists && (strings.HasPrefix(ai.actions[0], "ATTACH PARTITION ") || strings.Contains(ai.actions[0], "DEPENDS ON EXTENSION ")):
+		return ErrInvalidOption.at("", "", "if_exists", "IF EXISTS is not supported by ATTACH PARTITION and DEPENDS ON EXTENSION")
+	case len(ai.ownedBy) > 0 && ai.allInTablespace == "":
+		return ErrInvalidOption.at("", "", "owned_by", "OWNED BY applies to ALL IN TABLESPACE only")
+	}
+
+	if ai.allInTablespace != "" {
+		switch {
+		case ai.name != "" || ai.schema != "" || ai.ifExists:
+			return ErrInvalidOption.at("", "", "name", "ALL IN TABLESPACE cannot name an index")
+		case !strings.HasPrefix(ai.actions[0], "SET TABLESPACE "):
+			return ErrInvalidOption.at("", "", "operations", "ALL IN TABLESPACE only moves indexes to another tablespace")
+		}
+	}
+	return nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (ai *AlterIndex) IsStatement() {}
+
+// Build renders the ALTER INDEX statement for the given dialect
+func (ai *AlterIndex) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "ALTER INDEX operations"); err != nil {
+		return "", nil, err
+	}
+	sql, err := ai.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the ALTER INDEX statement for the target server
+func (ai *AlterIndex) BuildFor(target Target) (string, []any, error) {
+	required := []struct {
+		prefix  string
+		feature feature
+	}{
+		{"ATTACH PARTITION ", featureAttachIndexPartition},
+		{"ALTER COLUMN ", featureIndexColumnStatistics},
+		{"NO DEPENDS ON EXTENSION ", featureNoDependsOnExtension},
+	}
+	for _, action := range ai.actions {
+		for _, r := range required {
+			if !strings.HasPrefix(action, r.prefix) {
+				continue
+			}
+			if err := target.require(r.feature, "", "", "operations"); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	return ai.Build(target.Dialect)
+}
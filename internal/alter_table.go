@@ -12,10 +12,19 @@ type AlterTable struct {
 	Comment    string
 }
 
-// ColumnOperation represents a single operation on a column
+// ColumnOperation represents a single operation on a column, partition or constraint
 type ColumnOperation struct {
-	Operation AlterTableOperation
-	Column    *Column
+	Operation  AlterTableOperation
+	Column     *Column
+	Partition  *Partition       // Set for AttachPartitionOp and DetachPartitionOp
+	Constraint *TableConstraint // Set for the constraint operations
+}
+
+// TableConstraint is a named table constraint added or dropped through AlterTable
+type TableConstraint struct {
+	Name       string
+	Definition string // e.g. CHECK (total > 0) or FOREIGN KEY (user_id) REFERENCES users (id)
+	NotValid   bool   // Existing rows are not checked until ValidateConstraint (PostgreSQL)
 }
 
 // NewAlterTable initializes and returns a new AlterTable instance
@@ -67,8 +76,125 @@ func (t *AlterTable) AlterColumn(column *Column) *AlterTable {
 	return t
 }
 
-// ToSQL generates the SQL statement for ALTER TABLE
+// AttachPartition attaches an existing table as a partition, using the partition's bounds
+func (t *AlterTable) AttachPartition(partition *Partition) *AlterTable {
+	if partition != nil {
+		t.Operations = append(t.Operations, ColumnOperation{
+			Operation: AttachPartitionOp,
+			Partition: partition,
+		})
+	}
+	return t
+}
+
+// DetachPartition detaches a partition, turning it into a standalone table
+func (t *AlterTable) DetachPartition(partition *Partition) *AlterTable {
+	if partition != nil {
+		t.Operations = append(t.Operations, ColumnOperation{
+			Operation: DetachPartitionOp,
+			Partition: partition,
+		})
+	}
+	return t
+}
+
+// SetColumnDefault sets the default of an existing column to the column's Default
+func (t *AlterTable) SetColumnDefault(column *Column) *AlterTable {
+	if column != nil {
+		t.Operations = append(t.Operations, ColumnOperation{Operation: SetDefaultOp, Column: column})
+	}
+	return t
+}
+
+// SetColumnNotNull adds NOT NULL to an existing column (PostgreSQL)
+func (t *AlterTable) SetColumnNotNull(column *Column) *AlterTable {
+	if column != nil {
+		t.Operations = append(t.Operations, ColumnOperation{Operation: SetNotNullOp, Column: column})
+	}
+	return t
+}
+
+// AddConstraint adds a named constraint, e.g. AddConstraint("orders_total_check", "CHECK (total > 0)")
+func (t *AlterTable) AddConstraint(name, definition string) *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{
+		Operation:  AddConstraintOp,
+		Constraint: &TableConstraint{Name: name, Definition: definition},
+	})
+	return t
+}
+
+// AddConstraintNotValid adds a CHECK or FOREIGN KEY constraint without checking existing rows,
+// which ValidateConstraint does later without blocking writes (PostgreSQL)
+func (t *AlterTable) AddConstraintNotValid(name, definition string) *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{
+		Operation:  AddConstraintOp,
+		Constraint: &TableConstraint{Name: name, Definition: definition, NotValid: true},
+	})
+	return t
+}
+
+// ValidateConstraint checks existing rows against a constraint added NOT VALID (PostgreSQL)
+func (t *AlterTable) ValidateConstraint(name string) *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{Operation: ValidateConstraintOp, Constraint: &TableConstraint{Name: name}})
+	return t
+}
+
+// DropConstraint drops a named constraint
+func (t *AlterTable) DropConstraint(name string) *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{Operation: DropConstraintOp, Constraint: &TableConstraint{Name: name}})
+	return t
+}
+
+// EnableRowLevelSecurity enables row level security, restricting rows to those the table's policies allow
+func (t *AlterTable) EnableRowLevelSecurity() *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{Operation: EnableRowLevelSecurityOp})
+	return t
+}
+
+// DisableRowLevelSecurity disables row level security, the policies are kept but not applied
+func (t *AlterTable) DisableRowLevelSecurity() *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{Operation: DisableRowLevelSecurityOp})
+	return t
+}
+
+// ForceRowLevelSecurity applies row level security to the table owner as well
+func (t *AlterTable) ForceRowLevelSecurity() *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{Operation: ForceRowLevelSecurityOp})
+	return t
+}
+
+// NoForceRowLevelSecurity exempts the table owner from row level security again
+func (t *AlterTable) NoForceRowLevelSecurity() *AlterTable {
+	t.Operations = append(t.Operations, ColumnOperation{Operation: NoForceRowLevelSecurityOp})
+	return t
+}
+
+// ToSQL generates the SQL statement for ALTER TABLE. The table comment follows as a separate
+// statement
 func (t *AlterTable) ToSQL() (string, []error) {
+	sql, errs := t.render(PostgresDialect)
+	if len(errs) > 0 || t.Comment == "" {
+		return sql, errs
+	}
+	comment, err := NewTableComment(t.TableName, t.Comment).ToSQL()
+	if err != nil {
+		return "", []error{err}
+	}
+	return sql + "; " + comment, nil
+}
+
+// Statements returns the ALTER TABLE statement followed by the statement setting the table
+// comment, if any, ready for ExecAll
+func (t *AlterTable) Statements() []Statement {
+	statements := []Statement{t}
+	if t.Comment != "" {
+		statements = append(statements, NewTableComment(t.TableName, t.Comment))
+	}
+	return statements
+}
+
+// render generates the ALTER TABLE statement with string literals escaped for the dialect
+func (t *AlterTable) render(dialect Dialect) (string, []error) {
 	errors := t.Validate()
 	if len(errors) > 0 {
 		return "", errors
@@ -82,9 +208,9 @@ func (t *AlterTable) ToSQL() (string, []error) {
 	for _, op := range t.Operations {
 		switch op.Operation {
 		case AddColumnOp:
-			colSQL, err := op.Column.ToSQL()
+			colSQL, err := op.Column.render(dialect)
 			if err != nil {
-				errors = append(errors, err)
+				errors = append(errors, inTable(err, t.TableName))
 				continue
 			}
 			operationDefs = append(operationDefs, "ADD COLUMN "+colSQL)
@@ -94,23 +220,64 @@ func (t *AlterTable) ToSQL() (string, []error) {
 			operationDefs = append(operationDefs, "RENAME COLUMN "+op.Column.Name+" TO "+op.Column.UpdateOptions.Name)
 		case AlterColumnTypeOp:
 			operationDefs = append(operationDefs, "ALTER COLUMN "+op.Column.ToDataType()+" TYPE "+op.Column.ToNewDataType())
+		case AttachPartitionOp:
+			bound, err := op.Partition.boundSQL()
+			if err != nil {
+				errors = append(errors, err)
+				continue
+			}
+			operationDefs = append(operationDefs, "ATTACH PARTITION "+qualify(op.Partition.schema, op.Partition.name)+" "+bound)
+		case DetachPartitionOp:
+			detach := "DETACH PARTITION " + qualify(op.Partition.schema, op.Partition.name)
+			if op.Partition.concurrently {
+				detach += " CONCURRENTLY"
+			}
+			operationDefs = append(operationDefs, detach)
+		case SetDefaultOp:
+			if op.Column.Default == nil {
+				operationDefs = append(operationDefs, "ALTER COLUMN "+op.Column.Name+" DROP DEFAULT")
+				continue
+			}
+			defaultSQL, err := op.Column.Default.render(dialect, op.Column.DataType)
+			if err != nil {
+				errors = append(errors, inTable(err, t.TableName))
+				continue
+			}
+			operationDefs = append(operationDefs, "ALTER COLUMN "+op.Column.Name+" SET DEFAULT "+defaultSQL)
+		case SetNotNullOp:
+			operationDefs = append(operationDefs, "ALTER COLUMN "+op.Column.Name+" SET NOT NULL")
+		case AddConstraintOp:
+			if op.Constraint.Name == "" || op.Constraint.Definition == "" {
+				errors = append(errors, ErrRequired.at(t.TableName, "", "constraint", "constraint name and definition are required"))
+				continue
+			}
+			constraint := "ADD CONSTRAINT " + op.Constraint.Name + " " + op.Constraint.Definition
+			if op.Constraint.NotValid {
+				constraint += " NOT VALID"
+			}
+			operationDefs = append(operationDefs, constraint)
+		case ValidateConstraintOp:
+			operationDefs = append(operationDefs, "VALIDATE CONSTRAINT "+op.Constraint.Name)
+		case DropConstraintOp:
+			operationDefs = append(operationDefs, "DROP CONSTRAINT "+op.Constraint.Name)
+		case EnableRowLevelSecurityOp:
+			operationDefs = append(operationDefs, "ENABLE ROW LEVEL SECURITY")
+		case DisableRowLevelSecurityOp:
+			operationDefs = append(operationDefs, "DISABLE ROW LEVEL SECURITY")
+		case ForceRowLevelSecurityOp:
+			operationDefs = append(operationDefs, "FORCE ROW LEVEL SECURITY")
+		case NoForceRowLevelSecurityOp:
+			operationDefs = append(operationDefs, "NO FORCE ROW LEVEL SECURITY")
 		}
 	}
 
 	if len(operationDefs) == 0 {
-		errors = append(errors, fmt.Errorf("no valid operations defined for table %s", t.TableName))
+		errors = append(errors, ErrRequired.at(t.TableName, "", "operations", fmt.Sprintf("no valid operations defined for table %s", t.TableName)))
 		return "", errors
 	}
 
 	builder.WriteString(" " + strings.Join(operationDefs, ", "))
-
-	// Add table-level comment if provided
-	sql := builder.String()
-	if t.Comment != "" {
-		sql = sql + fmt.Sprintf(" COMMENT ON TABLE %s IS '%s'", t.TableName, t.Comment)
-	}
-
-	return sql, nil
+	return builder.String(), nil
 }
 
 // Validate validates the alter table operation
@@ -119,13 +286,77 @@ func (t *AlterTable) Validate() []error {
 
 	// Check if table name is empty
 	if t.TableName == "" {
-		errors = append(errors, fmt.Errorf("table name cannot be empty"))
+		errors = append(errors, ErrRequired.at("", "", "name", "table name cannot be empty"))
 	}
 
 	// Check if there are operations
 	if len(t.Operations) == 0 {
-		errors = append(errors, fmt.Errorf("alter table must have at least one operation"))
+		errors = append(errors, ErrRequired.at(t.TableName, "", "operations", "alter table must have at least one operation"))
 	}
 
 	return errors
 }
+
+// IsStatement implementation for SQL generation interface
+func (t *AlterTable) IsStatement() {}
+
+// Build renders the ALTER TABLE statement for the given dialect. The table comment is a
+// separate statement, returned by Statements
+func (t *AlterTable) Build(dialect Dialect) (string, []any, error) {
+	for _, op := range t.Operations {
+		switch op.Operation {
+		case AttachPartitionOp, DetachPartitionOp:
+			if err := requirePostgres(dialect, "partition operations"); err != nil {
+				return "", nil, err
+			}
+		case EnableRowLevelSecurityOp, DisableRowLevelSecurityOp, ForceRowLevelSecurityOp, NoForceRowLevelSecurityOp:
+			if err := requirePostgres(dialect, "row level security"); err != nil {
+				return "", nil, err
+			}
+		case SetNotNullOp, ValidateConstraintOp:
+			if err := requirePostgres(dialect, "SET NOT NULL and VALIDATE CONSTRAINT"); err != nil {
+				return "", nil, err
+			}
+		case AddConstraintOp, DropConstraintOp:
+			if dialect == SQLiteDialect {
+				return "", nil, ErrUnsupportedDialect.at(t.TableName, "", "operations", "sqlite cannot add or drop constraints on an existing table")
+			}
+			if op.Constraint.NotValid {
+				if err := requirePostgres(dialect, "NOT VALID constraints"); err != nil {
+					return "", nil, err
+				}
+			}
+		}
+	}
+	sql, errs := t.render(dialect)
+	return buildMulti(dialect, sql, errs)
+}
+
+// BuildFor renders the ALTER TABLE statement for the target server, checking each operation
+// like Table.BuildFor checks its columns
+func (t *AlterTable) BuildFor(target Target) (string, []any, error) {
+	alter := *t
+	alter.Operations = make([]ColumnOperation, len(t.Operations))
+	for i, op := range t.Operations {
+		var err error
+		switch op.Operation {
+		case AddColumnOp:
+			op.Column, err = op.Column.forTarget(target)
+		case DropColumnOp:
+			err = target.require(featureSQLiteDropColumn, t.TableName, op.Column.Name, "operations")
+		case RenameColumnOp:
+			err = target.require(featureSQLiteRenameColumn, t.TableName, op.Column.Name, "operations")
+		case AttachPartitionOp:
+			err = op.Partition.checkVersion(target)
+		case DetachPartitionOp:
+			if op.Partition.concurrently {
+				err = target.require(featureDetachConcurrently, t.TableName, "", "operations")
+			}
+		}
+		if err != nil {
+			return "", nil, inTable(err, t.TableName)
+		}
+		alter.Operations[i] = op
+	}
+	return alter.Build(target.Dialect)
+}
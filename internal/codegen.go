@@ -0,0 +1,147 @@
+package gomb
+
+import (
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+)
+
+// NullStyle selects how nullable columns are represented in generated Go code
+type NullStyle int
+
+const (
+	PointerNulls NullStyle = iota // *string, *int64, ...
+	SQLNulls                      // sql.NullString, sql.NullInt64, ...
+)
+
+// GoStructOptions configures Go struct generation
+type GoStructOptions struct {
+	Package   string    // Package name of the generated file (default "models")
+	TypeName  string    // Struct name (default: table name in CamelCase)
+	NullStyle NullStyle // Representation of nullable columns
+}
+
+// goType describes the Go representation of a DataType
+type goType struct {
+	value   string
+	sqlNull string
+}
+
+var goTypes = map[DataType]goType{
+	SerialType:   {value: "int64", sqlNull: "sql.NullInt64"},
+	IntegerType:  {value: "int64", sqlNull: "sql.NullInt64"},
+	StringType:   {value: "string", sqlNull: "sql.NullString"},
+	DecimalType:  {value: "float64", sqlNull: "sql.NullFloat64"},
+	BooleanType:  {value: "bool", sqlNull: "sql.NullBool"},
+	DateType:     {value: "time.Time", sqlNull: "sql.NullTime"},
+	DateTimeType: {value: "time.Time", sqlNull: "sql.NullTime"},
+	CustomType:   {value: "string", sqlNull: "sql.NullString"}, // Scanned in its text form
+}
+
+// commonInitialisms are kept upper case in generated identifiers
+var commonInitialisms = map[string]bool{
+	"api": true, "html": true, "http": true, "id": true, "ip": true, "json": true,
+	"sql": true, "ssl": true, "uid": true, "uri": true, "url": true, "uuid": true,
+}
+
+// GenerateGoStruct generates a gofmt-ed Go source file containing a struct for the table,
+// with json/db tags and constants for the table and column names
+func GenerateGoStruct(table *Table, options GoStructOptions) ([]byte, error) {
+	if errs := table.Validate(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	if len(table.Columns) == 0 {
+		return nil, fmt.Errorf("no columns defined for table %s", table.Name)
+	}
+
+	pkg := options.Package
+	if pkg == "" {
+		pkg = "models"
+	}
+	typeName := options.TypeName
+	if typeName == "" {
+		typeName = toCamelCase(table.Name)
+	}
+
+	var fields, constants strings.Builder
+	imports := map[string]bool{}
+
+	for _, col := range table.Columns {
+		if !validDataTypes[col.DataType] {
+			return nil, ErrInvalidDataType.at(table.Name, col.Name, "data_type", fmt.Sprintf("invalid data type: %s", col.DataType))
+		}
+
+		fieldName := toCamelCase(col.Name)
+		fieldType := goFieldType(col, options.NullStyle)
+		if strings.Contains(fieldType, "sql.") {
+			imports["database/sql"] = true
+		}
+		if strings.Contains(fieldType, "time.") {
+			imports["time"] = true
+		}
+
+		if col.Comment != "" {
+			fmt.Fprintf(&fields, "// %s\n", col.Comment)
+		}
+		fmt.Fprintf(&fields, "%s %s `json:\"%s\" db:\"%s\"`\n", fieldName, fieldType, col.Name, col.Name)
+		fmt.Fprintf(&constants, "%sColumn%s = %q\n", typeName, fieldName, col.Name)
+	}
+
+	var src strings.Builder
+	src.WriteString("// Code generated by gomb. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+
+	if len(imports) > 0 {
+		src.WriteString("import (\n")
+		for _, path := range []string{"database/sql", "time"} {
+			if imports[path] {
+				fmt.Fprintf(&src, "%q\n", path)
+			}
+		}
+		src.WriteString(")\n\n")
+	}
+
+	src.WriteString("const (\n")
+	fmt.Fprintf(&src, "%sTable = %q\n", typeName, table.Name)
+	src.WriteString(constants.String())
+	src.WriteString(")\n\n")
+
+	if table.Comment != "" {
+		fmt.Fprintf(&src, "// %s %s\n", typeName, table.Comment)
+	} else {
+		fmt.Fprintf(&src, "// %s is a row of the %s table\n", typeName, table.Name)
+	}
+	fmt.Fprintf(&src, "type %s struct {\n%s}\n", typeName, fields.String())
+
+	return format.Source([]byte(src.String()))
+}
+
+// goFieldType returns the Go type of a column, honouring nullability
+func goFieldType(col *Column, style NullStyle) string {
+	typ := goTypes[col.DataType]
+	if !col.IsNullable() {
+		return typ.value
+	}
+	if style == SQLNulls {
+		return typ.sqlNull
+	}
+	return "*" + typ.value
+}
+
+// toCamelCase converts a snake_case identifier such as user_id to UserID
+func toCamelCase(name string) string {
+	var builder strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == ' ' || r == '.'
+	}) {
+		if commonInitialisms[strings.ToLower(part)] {
+			builder.WriteString(strings.ToUpper(part))
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		builder.WriteString(string(runes))
+	}
+	return builder.String()
+}
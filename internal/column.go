@@ -1,9 +1,9 @@
 package gomb
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
-	"strconv"
+	"regexp"
 	"strings"
 )
 
@@ -11,6 +11,7 @@ import (
 type Column struct {
 	Name             string         `json:"name"`        // Column name
 	DataType         DataType       `json:"data_type"`   // Data type (e.g., VARCHAR, INTEGER, etc.)
+	TypeName         string         `json:"type_name"`   // Database type of a CustomType column (e.g., jsonb, uuid)
 	Length           int            `json:"length"`      // Length (e.g., VARCHAR(255)
 	Precision        int            `json:"precision"`   // For DECIMAL or numeric types
 	Scale            int            `json:"scale"`       // For DECIMAL or numeric types
@@ -18,9 +19,10 @@ type Column struct {
 	AutoNumber       bool           `json:"auto_number"` // Whether this column is auto-incrementing
 	AutoNumberStart  int            `json:"auto_number_start"`
 	AutoNumberPrefix string         `json:"auto_number_prefix"`
-	NotNull          bool           `json:"not_null"`       // Whether this column allows NULL values
+	NotNull          bool           `json:"not_null"`       // Whether this column is declared NOT NULL
+	Nullable         bool           `json:"nullable"`       // Whether this column is declared NULL explicitly
 	Unique           bool           `json:"unique"`         // Whether this column has a UNIQUE constraint
-	Default          string         `json:"default"`        // Default value for the column
+	Default          *DefaultExpr   `json:"default"`        // Default value for the column
 	Check            string         `json:"check"`          // CHECK constraint expression
 	References       string         `json:"references"`     // Foreign key reference (e.g., "other_table(column)")
 	Generated        string         `json:"generated"`      // Expression for generated columns
@@ -34,14 +36,37 @@ type Column struct {
 	// NewName          string         `json:"new_name"`
 	// NewDataType      DataType       `json:"new_data_type"`
 	UpdateOptions *ColumnUpdate `json:"update_options,omitempty"`
+
+	checkExpr Expr // Expression of SetCheckExpr, rendered per dialect
 }
 
+// Nullability is the declared nullability of a column
+type Nullability string
+
+const (
+	NullabilityUnspecified Nullability = ""         // Neither NULL nor NOT NULL is emitted
+	NullabilityNull        Nullability = "NULL"     // NULL is declared explicitly
+	NullabilityNotNull     Nullability = "NOT NULL" // NOT NULL is declared
+)
+
 // ColumnUpdate holds modification details for a column
 type ColumnUpdate struct {
 	Name     string   `json:"name,omitempty"`
 	DataType DataType `json:"data_type,omitempty"`
 }
 
+// UnmarshalJSON decodes a column, treating an empty default as no default
+func (c *Column) UnmarshalJSON(data []byte) error {
+	type column Column
+	if err := json.Unmarshal(data, (*column)(c)); err != nil {
+		return err
+	}
+	if c.Default != nil && c.Default.Kind == "" {
+		c.Default = nil
+	}
+	return nil
+}
+
 // NewTable initializes and returns a new Table instance
 func NewColumn(name string) *Column {
 	return &Column{Name: name}
@@ -95,31 +120,77 @@ func (c *Column) SetUnique() *Column {
 // SetNotNull marks the column as not allowing null values
 func (c *Column) SetNotNull() *Column {
 	c.NotNull = true
+	c.Nullable = false
 	return c
 }
 
+// SetNullable declares the column NULL explicitly
+func (c *Column) SetNullable() *Column {
+	c.Nullable = true
+	c.NotNull = false
+	return c
+}
+
+// SetNullability sets the declared nullability of the column
+func (c *Column) SetNullability(nullability Nullability) *Column {
+	c.NotNull = nullability == NullabilityNotNull
+	c.Nullable = nullability == NullabilityNull
+	return c
+}
+
+// Nullability returns the declared nullability of the column
+func (c *Column) Nullability() Nullability {
+	switch {
+	case c.NotNull:
+		return NullabilityNotNull
+	case c.Nullable:
+		return NullabilityNull
+	default:
+		return NullabilityUnspecified
+	}
+}
+
+// IsNullable reports whether the column can hold NULL: it is declared NULL, or its nullability is
+// unspecified and it is neither a primary key nor an auto-numbered column
+func (c *Column) IsNullable() bool {
+	switch c.Nullability() {
+	case NullabilityNotNull:
+		return false
+	case NullabilityNull:
+		return true
+	default:
+		return !c.PrimaryKey && !c.AutoNumber && c.DataType != SerialType
+	}
+}
+
 // SetDataType sets the data type of the column
 func (c *Column) SetDataType(dataType DataType) *Column {
 	c.DataType = dataType
 	return c
 }
 
-// SetDefault sets the default value for the column
+// SetCustomType sets a database type that has no DataType, e.g. jsonb, uuid or integer[],
+// rendered verbatim
+func (c *Column) SetCustomType(typeName string) *Column {
+	c.DataType = CustomType
+	c.TypeName = typeName
+	return c
+}
+
+// SetDefault sets the default value for the column. DefaultValue constants (e.g.
+// DefaultCurrentTimestamp) and *DefaultExpr (Func, RawSQL) are used as given. A plain string
+// that is a SQL keyword such as CURRENT_TIMESTAMP is kept verbatim; any other value is a
+// literal checked against the column data type.
 func (c *Column) SetDefault(defaultValue any) *Column {
 	switch v := defaultValue.(type) {
-	case string:
+	case *DefaultExpr:
 		c.Default = v
-	case int, int32, int64, float32, float64:
-		// For numeric values, convert to string without quotes
-		c.Default = fmt.Sprintf("%v", v)
-	case bool:
-		// For boolean values, convert to lowercase string without quotes
-		c.Default = fmt.Sprintf("%t", v)
-	case nil:
-		c.Default = "NULL"
+	case DefaultValue:
+		c.Default = RawSQL(string(v))
+	case string:
+		c.Default = keywordDefault(v)
 	default:
-		// For other types, convert to string and add quotes
-		c.Default = fmt.Sprintf("%v", v)
+		c.Default = Literal(v)
 	}
 	return c
 }
@@ -160,6 +231,24 @@ func (col *Column) SetCheck(check string) *Column {
 	col.Check = check
 	return col
 }
+
+// SetCheckExpr sets a CHECK constraint built with the expression API. CHECK constraints
+// cannot take bind parameters, so values are rendered as escaped literals.
+func (col *Column) SetCheckExpr(check Expr) *Column {
+	col.Check = "(" + InlineExpr(check) + ")"
+	col.checkExpr = check
+	return col
+}
+
+// checkSQL renders the CHECK expression, escaping the values of SetCheckExpr for the dialect
+// unless Check was changed since
+func (col *Column) checkSQL(dialect Dialect) string {
+	if col.checkExpr != nil && col.Check == "("+InlineExpr(col.checkExpr)+")" {
+		return "(" + InlineExprFor(dialect, col.checkExpr) + ")"
+	}
+	return col.Check
+}
+
 func (col *Column) SetGenerated(check string) *Column {
 	col.Generated = check
 	return col
@@ -216,6 +305,11 @@ func (col *Column) SetAttributes(attributes map[string]any) *Column {
 
 // ToSQL generates the SQL definition for the column
 func (c *Column) ToSQL() (string, error) {
+	return c.render(PostgresDialect)
+}
+
+// render generates the column definition with string literals escaped for the dialect
+func (c *Column) render(dialect Dialect) (string, error) {
 	// Validate the column definition first
 	if err := c.Validate(); err != nil {
 		return "", err
@@ -242,13 +336,13 @@ func (c *Column) ToSQL() (string, error) {
 			builder.WriteString(fmt.Sprintf(" START WITH %d", c.AutoNumberStart))
 		}
 		if c.AutoNumberPrefix != "" {
-			builder.WriteString(fmt.Sprintf(" PREFIX '%s'", c.AutoNumberPrefix))
+			builder.WriteString(" PREFIX " + QuoteStringFor(dialect, c.AutoNumberPrefix))
 		}
 	}
 
-	// Add not null constraint
-	if c.NotNull {
-		builder.WriteString(" NOT NULL")
+	// Add nullability
+	if nullability := c.Nullability(); nullability != NullabilityUnspecified {
+		builder.WriteString(" " + string(nullability))
 	}
 
 	// Add unique constraint
@@ -257,38 +351,17 @@ func (c *Column) ToSQL() (string, error) {
 	}
 
 	// Add default value
-	if c.Default != "" {
-		// Check if the default value needs quotes
-		needsQuotes := true
-
-		// Standard SQL functions/constants that don't need quotes
-		switch c.Default {
-		case "CURRENT_TIMESTAMP", "CURRENT_DATE", "CURRENT_TIME",
-			"LOCAL_TIME", "LOCAL_TIMESTAMP", "TRUE", "FALSE", "NULL":
-			needsQuotes = false
-		}
-
-		// Check if it's a numeric value
-		if _, err := strconv.ParseFloat(c.Default, 64); err == nil {
-			needsQuotes = false
-		}
-
-		// Boolean literals don't need quotes
-		if c.Default == "true" || c.Default == "false" {
-			needsQuotes = false
-		}
-
-		// Apply quotes if needed
-		if needsQuotes {
-			builder.WriteString(fmt.Sprintf(" DEFAULT '%s'", c.Default))
-		} else {
-			builder.WriteString(fmt.Sprintf(" DEFAULT %s", c.Default))
+	if c.Default != nil {
+		defaultSQL, err := c.Default.render(dialect, c.DataType)
+		if err != nil {
+			return "", err
 		}
+		builder.WriteString(" DEFAULT " + defaultSQL)
 	}
 
 	// Add check constraint
 	if c.Check != "" {
-		builder.WriteString(fmt.Sprintf(" CHECK %s", c.Check))
+		builder.WriteString(" CHECK " + c.checkSQL(dialect))
 	}
 
 	// Add references (foreign key)
@@ -308,7 +381,7 @@ func (c *Column) ToSQL() (string, error) {
 
 	// Add comment
 	if c.Comment != "" {
-		builder.WriteString(fmt.Sprintf(" COMMENT '%s'", c.Comment))
+		builder.WriteString(" COMMENT " + QuoteStringFor(dialect, c.Comment))
 	}
 
 	// Add storage option
@@ -334,6 +407,29 @@ func (c *Column) ToSQL() (string, error) {
 	return builder.String(), nil
 }
 
+// forTarget checks the column against the target server, returning a copy without
+// compression when the server cannot take it
+func (c *Column) forTarget(target Target) (*Column, error) {
+	if c.Generated != "" {
+		for _, f := range []feature{featureGeneratedColumns, featureSQLiteGenerated} {
+			if err := target.require(f, "", c.Name, "generated"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if c.Check != "" {
+		if err := target.require(featureMySQLCheck, "", c.Name, "check"); err != nil {
+			return nil, err
+		}
+	}
+	if c.Compression != "" && !target.supports(featureColumnCompression) {
+		column := *c
+		column.Compression = ""
+		return &column, nil
+	}
+	return c, nil
+}
+
 // Valid PostgreSQL data types for validation
 var validDataTypes = map[DataType]bool{
 	SerialType:   true,
@@ -343,40 +439,82 @@ var validDataTypes = map[DataType]bool{
 	BooleanType:  true,
 	DateType:     true,
 	DateTimeType: true,
+	CustomType:   true,
 }
 
+// customTypePattern matches type names such as jsonb, timestamp(3) with time zone,
+// numeric(10, 2)[] or public.mood
+var customTypePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_ .,()\[\]]*$`)
+
+// Validate checks the column definition and returns the first problem found
 func (col *Column) Validate() error {
+	if errs := col.validate(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// validate returns every problem with the column definition
+func (col *Column) validate() []error {
+	var errs []error
+
 	// Data Type Validation
 	if !validDataTypes[col.DataType] {
-		return fmt.Errorf("invalid data type: %s", col.DataType)
+		errs = append(errs, col.problem(ErrInvalidDataType, "data_type", fmt.Sprintf("invalid data type: %s", col.DataType)))
+	}
+	if col.DataType == CustomType && !customTypePattern.MatchString(col.TypeName) {
+		errs = append(errs, col.problem(ErrInvalidDataType, "type_name", fmt.Sprintf("invalid custom type: %q", col.TypeName)))
 	}
 
 	// Auto Number Start Validation
 	if col.AutoNumber && col.AutoNumberStart < 0 {
-		return errors.New("auto-number start must be greater or equal than 0")
+		errs = append(errs, col.problem(ErrInvalidAutoNumber, "auto_number_start", ""))
 	}
 
-	// NotNull and Default Validation
-	if col.NotNull && col.Default != "" {
-		return errors.New("column cannot be both NOT NULL and have a DEFAULT value")
+	// Nullability Validation; NOT NULL with a default is fine unless the default is NULL
+	if col.NotNull && col.Nullable {
+		errs = append(errs, col.problem(ErrConflictingNullability, "nullable", ""))
+	}
+	if col.PrimaryKey && col.Nullable {
+		errs = append(errs, col.problem(ErrConflictingNullability, "nullable", "primary key column cannot be NULL"))
+	}
+	if col.NotNull && col.Default.IsNull() {
+		errs = append(errs, col.problem(ErrNotNullDefault, "default", ""))
+	}
+
+	// Default value Validation
+	if col.Default != nil {
+		if err := col.Default.Validate(col.DataType); err != nil {
+			errs = append(errs, col.problem(ErrInvalidDefault, "default", err.Error()))
+		}
+	}
+
+	// Precision and Scale Validation
+	if col.Scale > 0 && col.Scale > col.Precision {
+		errs = append(errs, col.problem(ErrInvalidScale, "scale", ""))
 	}
 
 	// IdentityStart and IdentityInc Validation
 	if col.IdentityStart > 0 && col.IdentityInc <= 0 {
-		return errors.New("identity increment must be greater than 0")
+		errs = append(errs, col.problem(ErrInvalidIdentity, "identity_inc", ""))
 	}
 
 	// Check constraint validation (if applicable)
 	if col.Check != "" && !strings.Contains(col.Check, "(") {
-		return errors.New("check constraint must have an expression in parentheses")
+		errs = append(errs, col.problem(ErrInvalidCheck, "check", ""))
 	}
 
 	// References validation (foreign key format)
 	if col.References != "" && !strings.Contains(col.References, "(") {
-		return errors.New("foreign key references must be in the format 'table(column)'")
+		errs = append(errs, col.problem(ErrInvalidReference, "references", ""))
 	}
 
-	return nil
+	return errs
+}
+
+// problem locates a sentinel validation error at a field of the column
+func (col *Column) problem(sentinel *ValidationError, field, message string) error {
+	return sentinel.at("", col.Name, field, message)
 }
 
 // Map the DataType to corresponding PostgreSQL data type
@@ -412,6 +550,8 @@ func (col *Column) ToDataTypeString(data DataType) string {
 		return "DATE"
 	case DateTimeType:
 		return "TIMESTAMP"
+	case CustomType:
+		return col.TypeName
 	default:
 		return "VARCHAR" // Default to TEXT if type is unknown
 	}
@@ -420,7 +560,7 @@ func (col *Column) ToDataTypeString(data DataType) string {
 // IsValidDataType checks if the given data type is valid
 func IsValidDataType(dataType DataType) bool {
 	switch dataType {
-	case SerialType, StringType, IntegerType, DecimalType, BooleanType, DateType, DateTimeType:
+	case SerialType, StringType, IntegerType, DecimalType, BooleanType, DateType, DateTimeType, CustomType:
 		return true
 	default:
 		return false
@@ -13,6 +13,17 @@ const (
 	DropColumnOp
 	RenameColumnOp
 	AlterColumnTypeOp
+	AttachPartitionOp
+	DetachPartitionOp
+	EnableRowLevelSecurityOp
+	DisableRowLevelSecurityOp
+	ForceRowLevelSecurityOp
+	NoForceRowLevelSecurityOp
+	SetDefaultOp
+	SetNotNullOp
+	AddConstraintOp
+	ValidateConstraintOp
+	DropConstraintOp
 )
 
 // Define constants for each data type as a custom type
@@ -24,6 +35,7 @@ const (
 	BooleanType  DataType = "boolean"
 	DateType     DataType = "date"
 	DateTimeType DataType = "datetime"
+	CustomType   DataType = "custom" // Rendered as Column.TypeName, e.g. jsonb or uuid
 )
 
 // Constants for PostgreSQL data types (prefix 'Pg' for PostgreSQL)
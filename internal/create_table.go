@@ -2,16 +2,39 @@ package gomb
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
 // Table represents a database table
 type Table struct {
-	Name       string         `json:"name"`
-	Label      string         `json:"label"`
-	Columns    []*Column      `json:"columns"`
-	Attributes map[string]any `json:"attributes"`
-	Comment    string         `json:"comment"`
+	Name             string           `json:"name"`
+	Label            string           `json:"label"`
+	Columns          []*Column        `json:"columns"`
+	Indexes          []*Index         `json:"indexes,omitempty"`
+	Attributes       map[string]any   `json:"attributes"`
+	Comment          string           `json:"comment"`
+	PartitionBy      *PartitionBy     `json:"partition_by,omitempty"`
+	IfNotExists      bool             `json:"if_not_exists,omitempty"`
+	Temporary        bool             `json:"temporary,omitempty"`
+	Unlogged         bool             `json:"unlogged,omitempty"`           // PostgreSQL
+	Like             *TableLike       `json:"like,omitempty"`               // PostgreSQL
+	Inherits         []string         `json:"inherits,omitempty"`           // PostgreSQL
+	With             []string         `json:"with,omitempty"`               // Storage parameters, e.g. fillfactor=70 (PostgreSQL)
+	Tablespace       string           `json:"tablespace,omitempty"`         // PostgreSQL and MySQL
+	Engine           string           `json:"engine,omitempty"`             // MySQL
+	Charset          string           `json:"charset,omitempty"`            // MySQL
+	AsQuery          string           `json:"as_query,omitempty"`           // CREATE TABLE ... AS query
+	Privileges       []TablePrivilege `json:"privileges,omitempty"`         // Granted after the table is created
+	RowSecurity      bool             `json:"row_security,omitempty"`       // Enable row level security (PostgreSQL)
+	ForceRowSecurity bool             `json:"force_row_security,omitempty"` // Apply row level security to the owner too (PostgreSQL)
+	Policies         []TablePolicy    `json:"policies,omitempty"`           // Row level security policies (PostgreSQL)
+}
+
+// TableLike is a LIKE clause copying the definition of another table
+type TableLike struct {
+	Source  string   `json:"source"`
+	Options []string `json:"options,omitempty"` // e.g. INCLUDING ALL, EXCLUDING INDEXES
 }
 
 // NewTable initializes and returns a new Table instance
@@ -25,41 +48,257 @@ func (t *Table) AddColumn(column *Column) *Table {
 	return t
 }
 
-// TableDefinition generates the full SQL table definition by combining various table attributes
+// AddIndex adds an index definition to the table
+func (t *Table) AddIndex(index *Index) *Table {
+	t.Indexes = append(t.Indexes, index)
+	return t
+}
+
+// SetPartitionBy makes the table a partitioned table, e.g. PARTITION BY RANGE (created_at)
+func (t *Table) SetPartitionBy(strategy PartitionStrategy, columns ...string) *Table {
+	t.PartitionBy = &PartitionBy{Strategy: strategy, Columns: columns}
+	return t
+}
+
+// SetIfNotExists adds IF NOT EXISTS to the statement
+func (t *Table) SetIfNotExists() *Table {
+	t.IfNotExists = true
+	return t
+}
+
+// SetTemporary creates a TEMPORARY table
+func (t *Table) SetTemporary() *Table {
+	t.Temporary = true
+	return t
+}
+
+// SetUnlogged creates an UNLOGGED table (PostgreSQL)
+func (t *Table) SetUnlogged() *Table {
+	t.Unlogged = true
+	return t
+}
+
+// SetLike copies the definition of the source table, e.g. SetLike("events", "INCLUDING ALL")
+func (t *Table) SetLike(source string, options ...string) *Table {
+	t.Like = &TableLike{Source: source, Options: options}
+	return t
+}
+
+// AddInherits adds parent tables to the INHERITS clause (PostgreSQL)
+func (t *Table) AddInherits(parents ...string) *Table {
+	t.Inherits = append(t.Inherits, parents...)
+	return t
+}
+
+// AddWithOption adds a storage parameter to the WITH clause, e.g. "fillfactor=70"
+func (t *Table) AddWithOption(option string) *Table {
+	t.With = append(t.With, option)
+	return t
+}
+
+// SetTablespace sets the tablespace of the table
+func (t *Table) SetTablespace(tablespace string) *Table {
+	t.Tablespace = tablespace
+	return t
+}
+
+// SetEngine sets the storage engine of the table (MySQL)
+func (t *Table) SetEngine(engine string) *Table {
+	t.Engine = engine
+	return t
+}
+
+// SetCharset sets the default character set of the table (MySQL)
+func (t *Table) SetCharset(charset string) *Table {
+	t.Charset = charset
+	return t
+}
+
+// SetAs creates the table from the result of a query (CREATE TABLE ... AS query)
+func (t *Table) SetAs(query string) *Table {
+	t.AsQuery = query
+	return t
+}
+
+// AddPrivilege declares privileges the role holds on the table
+func (t *Table) AddPrivilege(role string, privileges ...Privilege) *Table {
+	t.Privileges = append(t.Privileges, TablePrivilege{Role: role, Privileges: privileges})
+	return t
+}
+
+// Grants returns the GRANT statements for the declared privileges
+func (t *Table) Grants() []*Grant {
+	grants := make([]*Grant, 0, len(t.Privileges))
+	for _, p := range t.Privileges {
+		grant := NewGrant(p.Privileges...).OnTable(t.Name).SetColumns(p.Columns...).To(p.Role)
+		if p.WithGrantOption {
+			grant.SetWithGrantOption()
+		}
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// SetRowSecurity enables row level security on the table; force also applies it to the table owner
+func (t *Table) SetRowSecurity(force bool) *Table {
+	t.RowSecurity = true
+	t.ForceRowSecurity = force
+	return t
+}
+
+// AddPolicy declares a row level security policy on the table, enabling row level security
+func (t *Table) AddPolicy(policy TablePolicy) *Table {
+	t.Policies = append(t.Policies, policy)
+	t.RowSecurity = true
+	return t
+}
+
+// RowSecurityStatement returns the ALTER TABLE statement enabling row level security, or nil if it is
+// not enabled
+func (t *Table) RowSecurityStatement() *AlterTable {
+	if !t.RowSecurity && !t.ForceRowSecurity && len(t.Policies) == 0 {
+		return nil
+	}
+	alter := NewAlterTable(t.Name).EnableRowLevelSecurity()
+	if t.ForceRowSecurity {
+		alter.ForceRowLevelSecurity()
+	}
+	return alter
+}
+
+// CreatePolicies returns the CREATE POLICY statements for the declared policies
+func (t *Table) CreatePolicies() []*Policy {
+	policies := make([]*Policy, 0, len(t.Policies))
+	for _, p := range t.Policies {
+		policy := NewPolicy(p.Name, t.Name).SetCommand(p.Command).To(p.Roles...).
+			SetUsing(p.Using).SetWithCheck(p.WithCheck)
+		if p.Restrictive {
+			policy.SetRestrictive()
+		}
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// CommentStatement returns the statement setting the table comment, or nil if there is none
+func (t *Table) CommentStatement() *TableComment {
+	if t.Comment == "" {
+		return nil
+	}
+	return NewTableComment(t.Name, t.Comment)
+}
+
+// Statements returns the CREATE TABLE statement followed by the table's comment, indexes,
+// grants and row level security, ready for ExecAll. The comment renders nothing on SQLite.
+func (t *Table) Statements() []Statement {
+	statements := []Statement{t}
+	if comment := t.CommentStatement(); comment != nil {
+		statements = append(statements, comment)
+	}
+	for _, index := range t.Indexes {
+		statements = append(statements, index)
+	}
+	for _, grant := range t.Grants() {
+		statements = append(statements, grant)
+	}
+	if alter := t.RowSecurityStatement(); alter != nil {
+		statements = append(statements, alter)
+	}
+	for _, policy := range t.CreatePolicies() {
+		statements = append(statements, policy)
+	}
+	return statements
+}
+
+// column returns the column with the given name, or nil if it does not exist
+func (t *Table) column(name string) *Column {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// TableDefinition generates the full SQL table definition by combining various table attributes.
+// The table comment follows as a separate statement
 func (t *Table) ToSQL() (string, []error) {
+	sql, errs := t.render(PostgresDialect)
+	if len(errs) > 0 || t.Comment == "" {
+		return sql, errs
+	}
+	comment, err := t.CommentStatement().ToSQL()
+	if err != nil {
+		return "", []error{err}
+	}
+	return sql + "; " + comment, nil
+}
+
+// render generates the table definition with string literals escaped for the dialect
+func (t *Table) render(dialect Dialect) (string, []error) {
 	var def []string
 	var errors []error
 
 	// Add table name
 	if t.Name == "" {
-		errors = append(errors, fmt.Errorf("table name cannot be empty"))
+		errors = append(errors, ErrRequired.at("", "", "name", "table name cannot be empty"))
 		return "", errors
 	}
-	def = append(def, fmt.Sprintf("CREATE TABLE %s", t.Name))
+	if err := t.validateOptions(); err != nil {
+		return "", append(errors, err)
+	}
+	def = append(def, t.createClause())
+
+	// CREATE TABLE ... AS takes its columns from the query
+	if t.AsQuery != "" {
+		def = append(def, t.storageClauses()...)
+		def = append(def, "AS "+t.AsQuery)
+		return strings.Join(def, " "), nil
+	}
 
 	// Add columns
 	columnDefs := []string{}
 	for _, col := range t.Columns {
-		colSQL, err := col.ToSQL()
+		colSQL, err := col.render(dialect)
 		if err != nil {
-			errors = append(errors, err)
+			errors = append(errors, inTable(err, t.Name))
 			continue // Skip this column if there's an error
 		}
 		columnDefs = append(columnDefs, colSQL)
 	}
 
+	// Add LIKE source table
+	if t.Like != nil {
+		like := "LIKE " + t.Like.Source
+		if len(t.Like.Options) > 0 {
+			like += " " + strings.Join(t.Like.Options, " ")
+		}
+		columnDefs = append(columnDefs, like)
+	}
+
 	if len(columnDefs) == 0 {
-		errors = append(errors, fmt.Errorf("no valid columns defined for table %s", t.Name))
+		errors = append(errors, ErrRequired.at(t.Name, "", "columns", fmt.Sprintf("no valid columns defined for table %s", t.Name)))
 		return "", errors
 	}
 
 	def = append(def, fmt.Sprintf("(%s)", strings.Join(columnDefs, ", ")))
 
-	// Add table-level comment if provided
-	if t.Comment != "" {
-		def = append(def, fmt.Sprintf("COMMENT ON TABLE %s IS '%s'", t.Name, t.Comment))
+	// Add parent tables
+	if len(t.Inherits) > 0 {
+		def = append(def, fmt.Sprintf("INHERITS (%s)", strings.Join(t.Inherits, ", ")))
 	}
 
+	// Add partitioning
+	if t.PartitionBy != nil {
+		partitionSQL, err := t.PartitionBy.ToSQL()
+		if err != nil {
+			return "", append(errors, inTable(err, t.Name))
+		}
+		def = append(def, partitionSQL)
+	}
+
+	def = append(def, t.storageClauses()...)
+
 	if len(errors) > 0 {
 		return "", errors
 	}
@@ -68,14 +307,182 @@ func (t *Table) ToSQL() (string, []error) {
 	return strings.Join(def, " "), nil
 }
 
-// Validate validates the table and its columns
+// createClause renders CREATE [TEMPORARY | UNLOGGED] TABLE [IF NOT EXISTS] name
+func (t *Table) createClause() string {
+	var builder strings.Builder
+	builder.WriteString("CREATE ")
+	if t.Temporary {
+		builder.WriteString("TEMPORARY ")
+	}
+	if t.Unlogged {
+		builder.WriteString("UNLOGGED ")
+	}
+	builder.WriteString("TABLE ")
+	if t.IfNotExists {
+		builder.WriteString("IF NOT EXISTS ")
+	}
+	builder.WriteString(t.Name)
+	return builder.String()
+}
+
+// storageClauses renders the WITH, TABLESPACE, ENGINE and CHARSET options
+func (t *Table) storageClauses() []string {
+	var clauses []string
+	if len(t.With) > 0 {
+		clauses = append(clauses, fmt.Sprintf("WITH (%s)", strings.Join(t.With, ", ")))
+	}
+	if t.Tablespace != "" {
+		clauses = append(clauses, "TABLESPACE "+t.Tablespace)
+	}
+	if t.Engine != "" {
+		clauses = append(clauses, "ENGINE="+t.Engine)
+	}
+	if t.Charset != "" {
+		clauses = append(clauses, "DEFAULT CHARSET="+t.Charset)
+	}
+	return clauses
+}
+
+// validateOptions rejects combinations of table options that cannot be rendered together
+func (t *Table) validateOptions() error {
+	switch {
+	case t.Temporary && t.Unlogged:
+		return ErrInvalidOption.at(t.Name, "", "unlogged", "a table cannot be both TEMPORARY and UNLOGGED")
+	case t.Like != nil && t.Like.Source == "":
+		return ErrRequired.at(t.Name, "", "like", "LIKE requires a source table")
+	case t.AsQuery != "" && (len(t.Columns) > 0 || t.Like != nil || len(t.Inherits) > 0 || t.PartitionBy != nil):
+		return ErrInvalidOption.at(t.Name, "", "as_query", "CREATE TABLE AS cannot declare columns, LIKE, INHERITS or PARTITION BY")
+	}
+	return nil
+}
+
+// checkDialect rejects table options the dialect does not support
+func (t *Table) checkDialect(dialect Dialect) error {
+	postgres := []Dialect{PostgresDialect}
+	options := []struct {
+		set      bool
+		field    string
+		option   string
+		dialects []Dialect
+	}{
+		{t.Unlogged, "unlogged", "UNLOGGED", postgres},
+		{t.Like != nil, "like", "LIKE", postgres},
+		{len(t.Inherits) > 0, "inherits", "INHERITS", postgres},
+		{t.PartitionBy != nil, "partition_by", "PARTITION BY", postgres},
+		{len(t.With) > 0, "with", "WITH", postgres},
+		{t.Tablespace != "", "tablespace", "TABLESPACE", []Dialect{PostgresDialect, MySQLDialect}},
+		{t.Engine != "", "engine", "ENGINE", []Dialect{MySQLDialect}},
+		{t.Charset != "", "charset", "CHARSET", []Dialect{MySQLDialect}},
+	}
+	for _, o := range options {
+		if o.set && !slices.Contains(o.dialects, dialect) {
+			return ErrUnsupportedDialect.at(t.Name, "", o.field, fmt.Sprintf("%s is not supported by %s", o.option, dialect))
+		}
+	}
+	return nil
+}
+
+// Validate runs the structural checks of the Validator on the table and its columns, e.g.
+// duplicate columns or multiple primary keys. Reserved or over-long identifiers, which are legal
+// once quoted, and foreign keys to other tables are left to the Validator.
 func (t *Table) Validate() []error {
-	var errors []error
+	validator := NewValidator(PostgresDialect).AddTable(t)
+	validator.structural = true
+	return validator.Validate()
+}
 
-	// Check if table name is empty
-	if t.Name == "" {
-		errors = append(errors, fmt.Errorf("table name cannot be empty"))
+// IsStatement implementation for SQL generation interface
+func (t *Table) IsStatement() {}
+
+// Build renders the CREATE TABLE statement for the given dialect. The table comment is a
+// separate statement, returned by Statements
+func (t *Table) Build(dialect Dialect) (string, []any, error) {
+	if err := validateDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	if err := t.checkDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	sql, errs := t.render(dialect)
+	return buildMulti(dialect, sql, errs)
+}
+
+// BuildFor renders the CREATE TABLE statement for the target server. Column compression is
+// left out on servers without it; other missing features are errors
+func (t *Table) BuildFor(target Target) (string, []any, error) {
+	table, err := t.forTarget(target)
+	if err != nil {
+		return "", nil, err
+	}
+	return table.Build(target.Dialect)
+}
+
+// forTarget checks the table against the target server, returning a copy without the
+// clauses the server cannot take
+func (t *Table) forTarget(target Target) (*Table, error) {
+	if t.PartitionBy != nil {
+		if err := target.require(featurePartitioning, t.Name, "", "partition_by"); err != nil {
+			return nil, err
+		}
+		if t.PartitionBy.Strategy == HashPartition {
+			if err := target.require(featureHashPartitioning, t.Name, "", "partition_by"); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	return errors
+	table := *t
+	table.Columns = make([]*Column, len(t.Columns))
+	for i, col := range t.Columns {
+		column, err := col.forTarget(target)
+		if err != nil {
+			return nil, inTable(err, t.Name)
+		}
+		table.Columns[i] = column
+	}
+	return &table, nil
+}
+
+// TableComment represents a statement setting the comment of a table
+type TableComment struct {
+	table   string
+	comment string
+}
+
+// NewTableComment creates a statement setting the comment of a table; an empty comment
+// removes it
+func NewTableComment(table, comment string) *TableComment {
+	return &TableComment{table: table, comment: comment}
+}
+
+// ToSQL generates the COMMENT ON TABLE statement for PostgreSQL
+func (c *TableComment) ToSQL() (string, error) {
+	return c.render(PostgresDialect)
+}
+
+// render generates COMMENT ON TABLE, or ALTER TABLE ... COMMENT on MySQL. SQLite keeps no
+// table comments, so nothing is rendered for it and Exec skips the statement
+func (c *TableComment) render(dialect Dialect) (string, error) {
+	if c.table == "" {
+		return "", ErrRequired.at("", "", "name", "table name cannot be empty")
+	}
+	switch dialect {
+	case MySQLDialect:
+		return fmt.Sprintf("ALTER TABLE %s COMMENT = %s", c.table, QuoteStringFor(dialect, c.comment)), nil
+	case SQLiteDialect:
+		return "", nil
+	}
+	if c.comment == "" {
+		return fmt.Sprintf("COMMENT ON TABLE %s IS NULL", c.table), nil
+	}
+	return fmt.Sprintf("COMMENT ON TABLE %s IS %s", c.table, QuoteString(c.comment)), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (c *TableComment) IsStatement() {}
+
+// Build renders the table comment for the given dialect
+func (c *TableComment) Build(dialect Dialect) (string, []any, error) {
+	sql, err := c.render(dialect)
+	return buildSingle(dialect, sql, err)
 }
@@ -0,0 +1,119 @@
+package gomb
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Format identifies the serialization format of a metadata document
+type Format string
+
+const (
+	JSONFormat Format = "json"
+	YAMLFormat Format = "yaml"
+	TOMLFormat Format = "toml"
+)
+
+// FormatFromPath returns the format matching the extension of the given file path
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return JSONFormat, nil
+	case ".yaml", ".yml":
+		return YAMLFormat, nil
+	case ".toml":
+		return TOMLFormat, nil
+	default:
+		return "", fmt.Errorf("unsupported metadata file extension: %s", filepath.Ext(path))
+	}
+}
+
+// Decode decodes a metadata document into v (e.g. *Table, *Column, *Index or a slice of them).
+// YAML and TOML documents use the same field names as the JSON tags.
+func Decode(format Format, data []byte, v any) error {
+	switch format {
+	case JSONFormat:
+		return json.Unmarshal(data, v)
+	case YAMLFormat:
+		return DecodeYAML(data, v)
+	case TOMLFormat:
+		return DecodeTOML(data, v)
+	default:
+		return fmt.Errorf("unsupported metadata format: %s", format)
+	}
+}
+
+// DecodeYAML decodes a YAML document into v. Anchors, aliases and merge keys
+// (e.g. `<<: *audit_columns`) are resolved before decoding.
+func DecodeYAML(data []byte, v any) error {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid YAML metadata: %w", err)
+	}
+	return decodeGeneric(normalizeYAML(raw), v)
+}
+
+// DecodeTOML decodes a TOML document into v
+func DecodeTOML(data []byte, v any) error {
+	var raw map[string]any
+	if err := toml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid TOML metadata: %w", err)
+	}
+	return decodeGeneric(raw, v)
+}
+
+// LoadFile reads a metadata file and decodes it into v based on its extension
+func LoadFile(path string, v any) error {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return Decode(format, data, v)
+}
+
+// decodeGeneric re-encodes a generic document as JSON so that the JSON tags
+// remain the single source of truth for field names
+func decodeGeneric(raw any, v any) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// normalizeYAML converts map[any]any values produced for non-string keys into
+// map[string]any so they can be encoded as JSON objects
+func normalizeYAML(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeYAML(item)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[fmt.Sprintf("%v", key)] = normalizeYAML(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
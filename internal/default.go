@@ -0,0 +1,274 @@
+package gomb
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultKind identifies how a default value is rendered
+type DefaultKind string
+
+const (
+	LiteralDefault  DefaultKind = "literal"  // A value checked and quoted according to the column data type
+	FunctionDefault DefaultKind = "function" // A SQL function call, e.g. gen_random_uuid()
+	RawDefault      DefaultKind = "raw"      // A SQL expression or keyword rendered verbatim, e.g. CURRENT_TIMESTAMP
+)
+
+// DefaultExpr is the DEFAULT clause of a column
+type DefaultExpr struct {
+	Kind  DefaultKind `json:"kind"`
+	Value any         `json:"value,omitempty"` // Literal value
+	Name  string      `json:"name,omitempty"`  // Function name
+	Args  []any       `json:"args,omitempty"`  // Function arguments, rendered as literals
+	SQL   string      `json:"sql,omitempty"`   // Raw SQL expression
+}
+
+// Literal creates a literal default, quoted according to the column data type
+func Literal(value any) *DefaultExpr {
+	return &DefaultExpr{Kind: LiteralDefault, Value: value}
+}
+
+// Func creates a SQL function call default, e.g. Func("gen_random_uuid")
+func Func(name string, args ...any) *DefaultExpr {
+	return &DefaultExpr{Kind: FunctionDefault, Name: name, Args: args}
+}
+
+// RawSQL creates a default rendered verbatim, e.g. RawSQL("CURRENT_TIMESTAMP")
+func RawSQL(sql string) *DefaultExpr {
+	return &DefaultExpr{Kind: RawDefault, SQL: sql}
+}
+
+// sqlKeywordDefaults are the keywords recognised by SetDefault and legacy string defaults
+var sqlKeywordDefaults = map[string]bool{
+	string(DefaultNull):             true,
+	string(DefaultTrue):             true,
+	string(DefaultFalse):            true,
+	string(DefaultCurrentTimestamp): true,
+	string(DefaultCurrentDate):      true,
+	string(DefaultCurrentTime):      true,
+	string(DefaultLocalTime):        true,
+	string(DefaultLocalTimestamp):   true,
+}
+
+// functionNamePattern matches an optionally schema-qualified function name
+var functionNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// numericPattern matches finite decimal numbers, e.g. -12.50 or 1e3
+var numericPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// keywordDefault interprets a plain string default from SetDefault, legacy metadata or a
+// struct tag: SQL keywords such as CURRENT_TIMESTAMP are kept verbatim, anything else is a
+// literal
+func keywordDefault(value string) *DefaultExpr {
+	trimmed := strings.TrimSpace(value)
+	if sqlKeywordDefaults[strings.ToUpper(trimmed)] {
+		return RawSQL(strings.ToUpper(trimmed))
+	}
+	return Literal(value)
+}
+
+// IsNull reports whether the default is NULL
+func (d *DefaultExpr) IsNull() bool {
+	if d == nil {
+		return false
+	}
+	switch d.Kind {
+	case LiteralDefault:
+		return d.Value == nil
+	case RawDefault:
+		return strings.EqualFold(strings.TrimSpace(d.SQL), "NULL")
+	default:
+		return false
+	}
+}
+
+// stableDefaultFunctions are the functions evaluated once per statement, which PostgreSQL can
+// store as the default of existing rows without rewriting the table
+var stableDefaultFunctions = map[string]bool{
+	"now":                   true,
+	"transaction_timestamp": true,
+	"statement_timestamp":   true,
+}
+
+// volatile reports whether the default may differ between rows, e.g. gen_random_uuid() or
+// clock_timestamp(). Raw expressions other than keywords, numbers and calls such as now() are
+// assumed volatile.
+func (d *DefaultExpr) volatile() bool {
+	if d == nil {
+		return false
+	}
+	switch d.Kind {
+	case LiteralDefault:
+		return false
+	case FunctionDefault:
+		return !stableDefaultFunctions[strings.ToLower(d.Name)]
+	default:
+		sql := strings.TrimSpace(d.SQL)
+		if name, ok := strings.CutSuffix(sql, "()"); ok && stableDefaultFunctions[strings.ToLower(strings.TrimSpace(name))] {
+			return false
+		}
+		return !sqlKeywordDefaults[strings.ToUpper(sql)] && !numericPattern.MatchString(sql)
+	}
+}
+
+// Validate checks the default against the column data type
+func (d *DefaultExpr) Validate(dataType DataType) error {
+	_, err := d.ToSQL(dataType)
+	return err
+}
+
+// ToSQL renders the default expression for a column of the given data type
+func (d *DefaultExpr) ToSQL(dataType DataType) (string, error) {
+	return d.render(PostgresDialect, dataType)
+}
+
+// render renders the default expression with string literals escaped for the dialect
+func (d *DefaultExpr) render(dialect Dialect, dataType DataType) (string, error) {
+	switch d.Kind {
+	case LiteralDefault:
+		return literalSQL(dialect, d.Value, dataType)
+	case FunctionDefault:
+		if !functionNamePattern.MatchString(d.Name) {
+			return "", fmt.Errorf("invalid default function name: %q", d.Name)
+		}
+		args := make([]string, len(d.Args))
+		for i, arg := range d.Args {
+			args[i] = QuoteLiteralFor(dialect, arg)
+		}
+		return fmt.Sprintf("%s(%s)", d.Name, strings.Join(args, ", ")), nil
+	case RawDefault:
+		if strings.TrimSpace(d.SQL) == "" {
+			return "", fmt.Errorf("raw default expression cannot be empty")
+		}
+		return d.SQL, nil
+	default:
+		return "", fmt.Errorf("invalid default kind: %s", d.Kind)
+	}
+}
+
+// literalSQL renders a literal after checking it is valid for the data type
+func literalSQL(dialect Dialect, value any, dataType DataType) (string, error) {
+	if value == nil {
+		return "NULL", nil
+	}
+	invalid := fmt.Errorf("default value %v is not a valid %s", value, dataType)
+
+	switch dataType {
+	case StringType:
+		if _, ok := value.(time.Time); ok {
+			return QuoteLiteralFor(dialect, value), nil
+		}
+		return QuoteStringFor(dialect, fmt.Sprintf("%v", value)), nil
+	case SerialType, IntegerType:
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return fmt.Sprintf("%d", v), nil
+		case float64:
+			// JSON numbers decode as float64
+			if v == float64(int64(v)) {
+				return strconv.FormatInt(int64(v), 10), nil
+			}
+		case string:
+			if n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return strconv.FormatInt(n, 10), nil
+			}
+		}
+		return "", invalid
+	case DecimalType:
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			return QuoteLiteralFor(dialect, v), nil
+		case string:
+			if numericPattern.MatchString(strings.TrimSpace(v)) {
+				return strings.TrimSpace(v), nil
+			}
+		}
+		return "", invalid
+	case BooleanType:
+		switch v := value.(type) {
+		case bool:
+			return QuoteLiteralFor(dialect, v), nil
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return QuoteLiteralFor(dialect, b), nil
+			}
+		}
+		return "", invalid
+	case DateType:
+		switch v := value.(type) {
+		case time.Time:
+			return QuoteStringFor(dialect, v.Format("2006-01-02")), nil
+		case string:
+			if _, err := time.Parse("2006-01-02", strings.TrimSpace(v)); err == nil {
+				return QuoteStringFor(dialect, strings.TrimSpace(v)), nil
+			}
+		}
+		return "", invalid
+	case DateTimeType:
+		switch v := value.(type) {
+		case time.Time:
+			return QuoteLiteralFor(dialect, v), nil
+		case string:
+			for _, layout := range timeLayouts {
+				if _, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+					return QuoteStringFor(dialect, strings.TrimSpace(v)), nil
+				}
+			}
+		}
+		return "", invalid
+	default:
+		return QuoteLiteralFor(dialect, value), nil
+	}
+}
+
+// UnmarshalJSON accepts either a DefaultExpr object or a plain string, for metadata written
+// before typed defaults existed; a plain string is a literal unless it is a SQL keyword such
+// as CURRENT_TIMESTAMP
+func (d *DefaultExpr) UnmarshalJSON(data []byte) error {
+	var legacy string
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		// An empty string means no default; Column.UnmarshalJSON drops the zero value
+		if legacy != "" {
+			*d = *keywordDefault(legacy)
+		}
+		return nil
+	}
+
+	type defaultExpr DefaultExpr
+	var expr defaultExpr
+	if err := json.Unmarshal(data, &expr); err != nil {
+		return err
+	}
+	*d = DefaultExpr(expr)
+	return nil
+}
+
+// parseCatalogDefault converts a default expression read from a database catalog, e.g.
+// 'active'::character varying, 42 or now(). Catalog expressions are trusted SQL, so anything
+// other than a literal or keyword is kept verbatim.
+func parseCatalogDefault(value string) *DefaultExpr {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "'") {
+		if end := strings.LastIndex(value, "'"); end > 0 {
+			return Literal(strings.ReplaceAll(value[1:end], "''", "'"))
+		}
+	}
+	uncast := value
+	if cast := strings.Index(value, "::"); cast > 0 {
+		uncast = value[:cast]
+	}
+	switch {
+	case uncast == "" || strings.EqualFold(uncast, "NULL"):
+		return nil
+	case sqlKeywordDefaults[strings.ToUpper(uncast)]:
+		return RawSQL(strings.ToUpper(uncast))
+	case numericPattern.MatchString(uncast):
+		return Literal(uncast)
+	default:
+		return RawSQL(value)
+	}
+}
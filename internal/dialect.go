@@ -0,0 +1,30 @@
+package gomb
+
+import "fmt"
+
+// Dialect identifies the SQL flavour of a database server
+type Dialect string
+
+const (
+	PostgresDialect Dialect = "postgres"
+	MySQLDialect    Dialect = "mysql"
+	SQLiteDialect   Dialect = "sqlite"
+)
+
+// IsValidDialect checks if the given dialect is supported
+func IsValidDialect(dialect Dialect) bool {
+	switch dialect {
+	case PostgresDialect, MySQLDialect, SQLiteDialect:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateDialect returns an error for unsupported dialects
+func validateDialect(dialect Dialect) error {
+	if !IsValidDialect(dialect) {
+		return ErrUnsupportedDialect.at("", "", "dialect", fmt.Sprintf("unsupported dialect: %s", dialect))
+	}
+	return nil
+}
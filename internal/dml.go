@@ -0,0 +1,333 @@
+package gomb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Insert represents an INSERT statement with bound values
+type Insert struct {
+	table       string
+	schema      string
+	columns     []string
+	rows        [][]any
+	returning   []string
+	placeholder PlaceholderStyle
+}
+
+// NewInsert creates a new insert builder
+func NewInsert(table string) *Insert {
+	return &Insert{table: table}
+}
+
+// SetSchema sets the schema of the table
+func (ins *Insert) SetSchema(schema string) *Insert {
+	ins.schema = schema
+	return ins
+}
+
+// Columns sets the inserted columns
+func (ins *Insert) Columns(columns ...string) *Insert {
+	ins.columns = append(ins.columns, columns...)
+	return ins
+}
+
+// Values adds a row of values; values may be expressions such as Raw("DEFAULT")
+func (ins *Insert) Values(values ...any) *Insert {
+	ins.rows = append(ins.rows, values)
+	return ins
+}
+
+// Returning adds a RETURNING clause (PostgreSQL and SQLite)
+func (ins *Insert) Returning(columns ...string) *Insert {
+	ins.returning = append(ins.returning, columns...)
+	return ins
+}
+
+// SetPlaceholder overrides the placeholder style of the dialect, e.g. NamedPlaceholder or
+// AtPlaceholder, which no built-in dialect uses by default
+func (ins *Insert) SetPlaceholder(style PlaceholderStyle) *Insert {
+	ins.placeholder = style
+	return ins
+}
+
+// Build renders the INSERT statement and its arguments for the given dialect
+func (ins *Insert) Build(dialect Dialect) (string, []any, error) {
+	if err := validateDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	w := newExprWriter(placeholderFor(dialect, ins.placeholder))
+	if err := ins.write(w, dialect); err != nil {
+		return "", nil, err
+	}
+	return w.builder.String(), w.args, nil
+}
+
+// ToSQL generates the INSERT statement for PostgreSQL with its values inlined as escaped
+// literals, e.g. for logs and scripts; Build binds them instead
+func (ins *Insert) ToSQL() (string, error) {
+	w := newInlineWriter(PostgresDialect)
+	if err := ins.write(w, PostgresDialect); err != nil {
+		return "", err
+	}
+	return w.builder.String(), nil
+}
+
+func (ins *Insert) write(w *exprWriter, dialect Dialect) error {
+	if ins.table == "" {
+		return fmt.Errorf("table name is required")
+	}
+	if len(ins.columns) == 0 {
+		return fmt.Errorf("at least one column is required for an insert")
+	}
+	if len(ins.rows) == 0 {
+		return fmt.Errorf("at least one row of values is required for an insert")
+	}
+	if err := checkReturning(dialect, ins.returning); err != nil {
+		return err
+	}
+
+	w.write("INSERT INTO " + qualify(ins.schema, ins.table))
+	w.write(" (" + strings.Join(ins.columns, ", ") + ") VALUES ")
+
+	for i, row := range ins.rows {
+		if len(row) != len(ins.columns) {
+			return fmt.Errorf("row %d has %d values, expected %d", i+1, len(row), len(ins.columns))
+		}
+		if i > 0 {
+			w.write(", ")
+		}
+		w.write("(")
+		for j, value := range row {
+			if j > 0 {
+				w.write(", ")
+			}
+			w.bind(ins.columns[j], value)
+		}
+		w.write(")")
+	}
+
+	writeReturning(w, ins.returning)
+	return w.err
+}
+
+// Update represents an UPDATE statement with bound values
+type Update struct {
+	table       string
+	schema      string
+	columns     []string
+	values      []any
+	where       []Expr
+	returning   []string
+	placeholder PlaceholderStyle
+}
+
+// NewUpdate creates a new update builder
+func NewUpdate(table string) *Update {
+	return &Update{table: table}
+}
+
+// SetSchema sets the schema of the table
+func (up *Update) SetSchema(schema string) *Update {
+	up.schema = schema
+	return up
+}
+
+// Set assigns a value (or expression, e.g. Raw("price * ?", 1.1)) to a column
+func (up *Update) Set(column string, value any) *Update {
+	up.columns = append(up.columns, column)
+	up.values = append(up.values, value)
+	return up
+}
+
+// Where adds a condition; multiple conditions are combined with AND
+func (up *Update) Where(condition Expr) *Update {
+	up.where = append(up.where, condition)
+	return up
+}
+
+// Returning adds a RETURNING clause (PostgreSQL and SQLite)
+func (up *Update) Returning(columns ...string) *Update {
+	up.returning = append(up.returning, columns...)
+	return up
+}
+
+// SetPlaceholder overrides the placeholder style of the dialect, e.g. NamedPlaceholder or
+// AtPlaceholder, which no built-in dialect uses by default
+func (up *Update) SetPlaceholder(style PlaceholderStyle) *Update {
+	up.placeholder = style
+	return up
+}
+
+// Build renders the UPDATE statement and its arguments for the given dialect
+func (up *Update) Build(dialect Dialect) (string, []any, error) {
+	if err := validateDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	w := newExprWriter(placeholderFor(dialect, up.placeholder))
+	if err := up.write(w, dialect); err != nil {
+		return "", nil, err
+	}
+	return w.builder.String(), w.args, nil
+}
+
+// ToSQL generates the UPDATE statement for PostgreSQL with its values inlined as escaped
+// literals, e.g. for logs and scripts; Build binds them instead
+func (up *Update) ToSQL() (string, error) {
+	w := newInlineWriter(PostgresDialect)
+	if err := up.write(w, PostgresDialect); err != nil {
+		return "", err
+	}
+	return w.builder.String(), nil
+}
+
+func (up *Update) write(w *exprWriter, dialect Dialect) error {
+	if up.table == "" {
+		return fmt.Errorf("table name is required")
+	}
+	if len(up.columns) == 0 {
+		return fmt.Errorf("at least one column is required for an update")
+	}
+	if err := checkReturning(dialect, up.returning); err != nil {
+		return err
+	}
+
+	w.write("UPDATE " + qualify(up.schema, up.table) + " SET ")
+	for i, column := range up.columns {
+		if i > 0 {
+			w.write(", ")
+		}
+		w.write(column + " = ")
+		w.bind(column, up.values[i])
+	}
+
+	writeWhere(w, up.where)
+	writeReturning(w, up.returning)
+	return w.err
+}
+
+// Delete represents a DELETE statement with bound values
+type Delete struct {
+	table       string
+	schema      string
+	where       []Expr
+	returning   []string
+	placeholder PlaceholderStyle
+}
+
+// NewDelete creates a new delete builder
+func NewDelete(table string) *Delete {
+	return &Delete{table: table}
+}
+
+// SetSchema sets the schema of the table
+func (del *Delete) SetSchema(schema string) *Delete {
+	del.schema = schema
+	return del
+}
+
+// Where adds a condition; multiple conditions are combined with AND
+func (del *Delete) Where(condition Expr) *Delete {
+	del.where = append(del.where, condition)
+	return del
+}
+
+// Returning adds a RETURNING clause (PostgreSQL and SQLite)
+func (del *Delete) Returning(columns ...string) *Delete {
+	del.returning = append(del.returning, columns...)
+	return del
+}
+
+// SetPlaceholder overrides the placeholder style of the dialect, e.g. NamedPlaceholder or
+// AtPlaceholder, which no built-in dialect uses by default
+func (del *Delete) SetPlaceholder(style PlaceholderStyle) *Delete {
+	del.placeholder = style
+	return del
+}
+
+// Build renders the DELETE statement and its arguments for the given dialect
+func (del *Delete) Build(dialect Dialect) (string, []any, error) {
+	if err := validateDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	w := newExprWriter(placeholderFor(dialect, del.placeholder))
+	if err := del.write(w, dialect); err != nil {
+		return "", nil, err
+	}
+	return w.builder.String(), w.args, nil
+}
+
+// ToSQL generates the DELETE statement for PostgreSQL with its values inlined as escaped
+// literals, e.g. for logs and scripts; Build binds them instead
+func (del *Delete) ToSQL() (string, error) {
+	w := newInlineWriter(PostgresDialect)
+	if err := del.write(w, PostgresDialect); err != nil {
+		return "", err
+	}
+	return w.builder.String(), nil
+}
+
+func (del *Delete) write(w *exprWriter, dialect Dialect) error {
+	if del.table == "" {
+		return fmt.Errorf("table name is required")
+	}
+	if err := checkReturning(dialect, del.returning); err != nil {
+		return err
+	}
+
+	w.write("DELETE FROM " + qualify(del.schema, del.table))
+	writeWhere(w, del.where)
+	writeReturning(w, del.returning)
+	return w.err
+}
+
+// IsStatement implementation for SQL generation interface
+func (ins *Insert) IsStatement() {}
+
+// IsStatement implementation for SQL generation interface
+func (up *Update) IsStatement() {}
+
+// IsStatement implementation for SQL generation interface
+func (del *Delete) IsStatement() {}
+
+// placeholderFor returns the explicit placeholder style, or the dialect's default
+func placeholderFor(dialect Dialect, style PlaceholderStyle) PlaceholderStyle {
+	if style != 0 {
+		return style
+	}
+	return dialect.Placeholder()
+}
+
+// qualify prefixes a name with its schema, if any
+func qualify(schema, name string) string {
+	if schema == "" {
+		return name
+	}
+	return schema + "." + name
+}
+
+func checkReturning(dialect Dialect, returning []string) error {
+	if len(returning) > 0 && dialect == MySQLDialect {
+		return fmt.Errorf("RETURNING is not supported by %s", dialect)
+	}
+	return nil
+}
+
+func writeWhere(w *exprWriter, conditions []Expr) {
+	if len(conditions) == 0 {
+		return
+	}
+	w.write(" WHERE ")
+	for i, condition := range conditions {
+		if i > 0 {
+			w.write(" AND ")
+		}
+		condition.writeExpr(w)
+	}
+}
+
+func writeReturning(w *exprWriter, returning []string) {
+	if len(returning) > 0 {
+		w.write(" RETURNING " + strings.Join(returning, ", "))
+	}
+}
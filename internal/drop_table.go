@@ -2,35 +2,279 @@ package gomb
 
 import (
 	"fmt"
+	"strings"
 )
 
 type DropTable struct {
-	Name    string
-	Cascade bool // If true, adds CASCADE to the DROP statement
+	Name     string
+	Names    []string // Additional tables dropped by the same statement
+	Schema   string
+	IfExists bool // Adds IF EXISTS, enabled by NewDropTable
+	Cascade  bool // If true, adds CASCADE to the DROP statement
+	Restrict bool // If true, adds RESTRICT to the DROP statement
 }
 
 // NewTable initializes and returns a new Table instance
-func NewDropTable(name string) *DropTable {
-	return &DropTable{Name: name}
+func NewDropTable(name string, names ...string) *DropTable {
+	return &DropTable{Name: name, Names: names, IfExists: true}
+}
+
+// AddTable adds tables to drop in the same statement
+func (t *DropTable) AddTable(names ...string) *DropTable {
+	t.Names = append(t.Names, names...)
+	return t
+}
+
+// SetSchema sets the schema of the dropped tables
+func (t *DropTable) SetSchema(schema string) *DropTable {
+	t.Schema = schema
+	return t
+}
+
+// SetIfExists enables or disables the IF EXISTS option, which NewDropTable enables
+func (t *DropTable) SetIfExists(ifExists bool) *DropTable {
+	t.IfExists = ifExists
+	return t
 }
 
 // SetCascade enables or disables the CASCADE option
 func (t *DropTable) SetCascade(cascade bool) *DropTable {
 	t.Cascade = cascade
+	if cascade {
+		t.Restrict = false
+	}
+	return t
+}
+
+// SetRestrict enables or disables the RESTRICT option
+func (t *DropTable) SetRestrict(restrict bool) *DropTable {
+	t.Restrict = restrict
+	if restrict {
+		t.Cascade = false
+	}
 	return t
 }
 
 // ToSQL generates the DROP TABLE SQL statement
 func (t *DropTable) ToSQL() (string, error) {
-	if t.Name == "" {
-		return "", fmt.Errorf("table name cannot be empty")
+	return t.render(PostgresDialect)
+}
+
+func (t *DropTable) render(dialect Dialect) (string, error) {
+	names := append([]string{t.Name}, t.Names...)
+	for _, name := range names {
+		if name == "" {
+			return "", ErrRequired.at("", "", "name", "table name cannot be empty")
+		}
+	}
+	if t.Cascade && t.Restrict {
+		return "", ErrInvalidOption.at(t.Name, "", "cascade", "CASCADE and RESTRICT are mutually exclusive")
+	}
+	if dialect == SQLiteDialect {
+		if len(names) > 1 {
+			return "", ErrUnsupportedDialect.at(t.Name, "", "names", "sqlite drops one table per statement")
+		}
+		if t.Cascade || t.Restrict {
+			return "", ErrUnsupportedDialect.at(t.Name, "", "cascade", "CASCADE and RESTRICT are not supported by sqlite")
+		}
 	}
 
 	// Construct DROP TABLE statement
-	sql := fmt.Sprintf("DROP TABLE IF EXISTS %s", t.Name)
+	sql := "DROP TABLE "
+	if t.IfExists {
+		sql += "IF EXISTS "
+	}
+	sql += qualifyAll(t.Schema, names)
 	if t.Cascade {
 		sql += " CASCADE"
 	}
+	if t.Restrict {
+		sql += " RESTRICT"
+	}
 
 	return sql, nil
 }
+
+// IsStatement implementation for SQL generation interface
+func (t *DropTable) IsStatement() {}
+
+// Build renders the DROP TABLE statement for the given dialect
+func (t *DropTable) Build(dialect Dialect) (string, []any, error) {
+	sql, err := t.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// Truncate represents a TRUNCATE TABLE statement
+type Truncate struct {
+	tables          []string
+	schema          string
+	restartIdentity bool
+	cascade         bool
+}
+
+// NewTruncate creates a new truncate builder
+func NewTruncate(tables ...string) *Truncate {
+	return &Truncate{tables: tables}
+}
+
+// SetSchema sets the schema of the truncated tables
+func (tr *Truncate) SetSchema(schema string) *Truncate {
+	tr.schema = schema
+	return tr
+}
+
+// SetRestartIdentity resets the sequences owned by the tables (PostgreSQL)
+func (tr *Truncate) SetRestartIdentity() *Truncate {
+	tr.restartIdentity = true
+	return tr
+}
+
+// SetCascade also truncates tables referencing the truncated tables (PostgreSQL)
+func (tr *Truncate) SetCascade() *Truncate {
+	tr.cascade = true
+	return tr
+}
+
+// ToSQL generates the TRUNCATE statement for PostgreSQL
+func (tr *Truncate) ToSQL() (string, error) {
+	return tr.render(PostgresDialect)
+}
+
+// render generates the statement for the dialect; SQLite has no TRUNCATE, so a single
+// table is emptied with DELETE FROM
+func (tr *Truncate) render(dialect Dialect) (string, error) {
+	if len(tr.tables) == 0 {
+		return "", ErrRequired.at("", "", "tables", "at least one table is required for a truncate")
+	}
+	for _, name := range tr.tables {
+		if name == "" {
+			return "", ErrRequired.at("", "", "tables", "table name cannot be empty")
+		}
+	}
+
+	if dialect != PostgresDialect {
+		if len(tr.tables) > 1 {
+			return "", ErrUnsupportedDialect.at(tr.tables[0], "", "tables", fmt.Sprintf("%s truncates one table per statement", dialect))
+		}
+		if tr.restartIdentity || tr.cascade {
+			return "", ErrUnsupportedDialect.at(tr.tables[0], "", "options", fmt.Sprintf("RESTART IDENTITY and CASCADE are not supported by %s", dialect))
+		}
+		if dialect == SQLiteDialect {
+			return "DELETE FROM " + qualify(tr.schema, tr.tables[0]), nil
+		}
+	}
+
+	var sql strings.Builder
+	sql.WriteString("TRUNCATE TABLE ")
+	sql.WriteString(qualifyAll(tr.schema, tr.tables))
+	if tr.restartIdentity {
+		sql.WriteString(" RESTART IDENTITY")
+	}
+	if tr.cascade {
+		sql.WriteString(" CASCADE")
+	}
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (tr *Truncate) IsStatement() {}
+
+// Build renders the TRUNCATE statement for the given dialect
+func (tr *Truncate) Build(dialect Dialect) (string, []any, error) {
+	sql, err := tr.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// DropSchema represents a DROP SCHEMA statement
+type DropSchema struct {
+	names    []string
+	ifExists bool
+	cascade  bool
+	restrict bool
+}
+
+// NewDropSchema creates a new drop schema builder
+func NewDropSchema(names ...string) *DropSchema {
+	return &DropSchema{names: names}
+}
+
+// SetIfExists adds IF EXISTS to the statement
+func (s *DropSchema) SetIfExists() *DropSchema {
+	s.ifExists = true
+	return s
+}
+
+// SetCascade also drops the objects contained in the schemas (PostgreSQL)
+func (s *DropSchema) SetCascade() *DropSchema {
+	s.cascade = true
+	s.restrict = false
+	return s
+}
+
+// SetRestrict refuses to drop a schema that is not empty, the default (PostgreSQL)
+func (s *DropSchema) SetRestrict() *DropSchema {
+	s.restrict = true
+	s.cascade = false
+	return s
+}
+
+// ToSQL generates the DROP SCHEMA statement for PostgreSQL
+func (s *DropSchema) ToSQL() (string, error) {
+	return s.render(PostgresDialect)
+}
+
+// render generates the statement for the dialect; on MySQL a schema is a database, which is
+// dropped with its tables one at a time
+func (s *DropSchema) render(dialect Dialect) (string, error) {
+	if len(s.names) == 0 {
+		return "", ErrRequired.at("", "", "names", "at least one schema is required")
+	}
+	for _, name := range s.names {
+		if name == "" {
+			return "", ErrRequired.at("", "", "names", "schema name cannot be empty")
+		}
+	}
+	switch dialect {
+	case SQLiteDialect:
+		return "", ErrUnsupportedDialect.at("", "", "schema", "sqlite does not support schemas")
+	case MySQLDialect:
+		if len(s.names) > 1 {
+			return "", ErrUnsupportedDialect.at("", "", "names", "mysql drops one schema per statement")
+		}
+		if s.cascade || s.restrict {
+			return "", ErrUnsupportedDialect.at("", "", "cascade", "CASCADE and RESTRICT are not supported by mysql")
+		}
+	}
+
+	var sql strings.Builder
+	sql.WriteString("DROP SCHEMA ")
+	if s.ifExists {
+		sql.WriteString("IF EXISTS ")
+	}
+	sql.WriteString(strings.Join(s.names, ", "))
+	if s.cascade {
+		sql.WriteString(" CASCADE")
+	}
+	if s.restrict {
+		sql.WriteString(" RESTRICT")
+	}
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (s *DropSchema) IsStatement() {}
+
+// Build renders the DROP SCHEMA statement for the given dialect
+func (s *DropSchema) Build(dialect Dialect) (string, []any, error) {
+	sql, err := s.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// qualifyAll prefixes each name with the schema and joins them with commas
+func qualifyAll(schema string, names []string) string {
+	qualified := make([]string, len(names))
+	for i, name := range names {
+		qualified[i] = qualify(schema, name)
+	}
+	return strings.Join(qualified, ", ")
+}
@@ -0,0 +1,85 @@
+package gomb
+
+import (
+	"errors"
+	"strings"
+)
+
+// ValidationError describes an invalid definition, located by table, column and field so
+// that callers can map it to a form field. It matches the sentinel with the same Code
+// under errors.Is.
+type ValidationError struct {
+	Table   string `json:"table,omitempty"`
+	Column  string `json:"column,omitempty"`
+	Field   string `json:"field,omitempty"` // JSON name of the offending field, e.g. "scale"
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Sentinel validation errors, use with errors.Is
+var (
+	ErrRequired               = &ValidationError{Code: "required", Message: "value is required"}
+	ErrInvalidDataType        = &ValidationError{Code: "invalid_data_type", Message: "invalid data type"}
+	ErrDuplicateName          = &ValidationError{Code: "duplicate_name", Message: "duplicate name"}
+	ErrInvalidDefault         = &ValidationError{Code: "invalid_default", Message: "invalid default value"}
+	ErrNotNullDefault         = &ValidationError{Code: "not_null_default", Message: "NOT NULL column cannot have a NULL default"}
+	ErrConflictingNullability = &ValidationError{Code: "conflicting_nullability", Message: "column cannot be both NULL and NOT NULL"}
+	ErrInvalidScale           = &ValidationError{Code: "invalid_scale", Message: "scale cannot be greater than precision"}
+	ErrInvalidAutoNumber      = &ValidationError{Code: "invalid_auto_number", Message: "auto-number start must be greater or equal than 0"}
+	ErrInvalidIdentity        = &ValidationError{Code: "invalid_identity", Message: "identity increment must be greater than 0"}
+	ErrInvalidCheck           = &ValidationError{Code: "invalid_check", Message: "check constraint must have an expression in parentheses"}
+	ErrInvalidReference       = &ValidationError{Code: "invalid_reference", Message: "foreign key references must be in the format 'table(column)'"}
+	ErrUnknownReference       = &ValidationError{Code: "unknown_reference", Message: "foreign key references an unknown table or column"}
+	ErrMultiplePrimaryKeys    = &ValidationError{Code: "multiple_primary_keys", Message: "multiple PRIMARY KEY columns"}
+	ErrMissingLength          = &ValidationError{Code: "missing_length", Message: "length is required"}
+	ErrGeneratedDefault       = &ValidationError{Code: "generated_default", Message: "generated column cannot have a DEFAULT value"}
+	ErrReservedIdentifier     = &ValidationError{Code: "reserved_identifier", Message: "identifier is a reserved word"}
+	ErrIdentifierTooLong      = &ValidationError{Code: "identifier_too_long", Message: "identifier is too long"}
+	ErrInvalidPartition       = &ValidationError{Code: "invalid_partition", Message: "invalid partition definition"}
+	ErrInvalidOption          = &ValidationError{Code: "invalid_option", Message: "invalid option"}
+	ErrUnsupportedDialect     = &ValidationError{Code: "unsupported_dialect", Message: "unsupported dialect"}
+	ErrUnsupportedVersion     = &ValidationError{Code: "unsupported_version", Message: "not supported by the target server version"}
+	ErrNotOnline              = &ValidationError{Code: "not_online", Message: "operation cannot be performed online"}
+)
+
+// Path returns the location of the error, e.g. "orders.total.scale"
+func (e *ValidationError) Path() string {
+	var parts []string
+	for _, part := range []string{e.Table, e.Column, e.Field} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func (e *ValidationError) Error() string {
+	if path := e.Path(); path != "" {
+		return path + ": " + e.Message
+	}
+	return e.Message
+}
+
+// Is reports whether the target is a ValidationError with the same code
+func (e *ValidationError) Is(target error) bool {
+	t, ok := target.(*ValidationError)
+	return ok && t.Code == e.Code
+}
+
+// at returns a copy of the sentinel located at the given table, column and field; an
+// empty message keeps the sentinel's message
+func (e *ValidationError) at(table, column, field, message string) *ValidationError {
+	if message == "" {
+		message = e.Message
+	}
+	return &ValidationError{Table: table, Column: column, Field: field, Code: e.Code, Message: message}
+}
+
+// inTable sets the table of a ValidationError that was raised without one
+func inTable(err error, table string) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) && validationErr.Table == "" {
+		validationErr.Table = table
+	}
+	return err
+}
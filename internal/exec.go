@@ -0,0 +1,262 @@
+package gomb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Execer is implemented by *sql.DB, *sql.Tx and *sql.Conn
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Queryer is implemented by *sql.DB, *sql.Tx and *sql.Conn
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// DB is a connection that can both execute statements and run queries
+type DB interface {
+	Execer
+	Queryer
+}
+
+// ExecError wraps an error returned by the driver while executing a statement
+type ExecError struct {
+	Builder   string // Builder type, e.g. "*gomb.Table"
+	Statement string // Rendered SQL
+	Err       error  // Driver error
+}
+
+func (e *ExecError) Error() string {
+	return fmt.Sprintf("exec %s: %v (statement: %s)", e.Builder, e.Err, e.Statement)
+}
+
+func (e *ExecError) Unwrap() error {
+	return e.Err
+}
+
+// dialectConn attaches a dialect and server version to a connection
+type dialectConn struct {
+	DB
+	dialect Dialect
+	version Version
+}
+
+func (c *dialectConn) Dialect() Dialect {
+	return c.dialect
+}
+
+func (c *dialectConn) Version() Version {
+	return c.version
+}
+
+// WithDialect returns a connection rendering statements for the given dialect
+func WithDialect(db DB, dialect Dialect) DB {
+	return &dialectConn{DB: db, dialect: dialect}
+}
+
+// WithTarget returns a connection rendering statements for the given server, so that
+// features the server version lacks are rejected before they reach it
+func WithTarget(db DB, target Target) DB {
+	return &dialectConn{DB: db, dialect: target.Dialect, version: target.Version}
+}
+
+// TargetOf returns the target server of a connection: its dialect as returned by DialectOf
+// and the version attached with WithTarget, if any
+func TargetOf(db any) Target {
+	target := Target{Dialect: DialectOf(db)}
+	if conn, ok := db.(interface{ Version() Version }); ok {
+		target.Version = conn.Version()
+	}
+	return target
+}
+
+// DialectOf returns the dialect of a connection: the one attached with WithDialect,
+// the one detected from the driver of a *sql.DB, or PostgresDialect. A *sql.Tx or *sql.Conn
+// does not expose its driver, so it falls back to PostgresDialect; wrap it with WithDialect
+// when it targets another server
+func DialectOf(db any) Dialect {
+	switch conn := db.(type) {
+	case interface{ Dialect() Dialect }:
+		return conn.Dialect()
+	case *sql.DB:
+		return detectDialect(conn)
+	default:
+		return PostgresDialect
+	}
+}
+
+// detectDialect guesses the dialect from the package path of the database driver
+func detectDialect(db *sql.DB) Dialect {
+	typ := reflect.TypeOf(db.Driver())
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	path := strings.ToLower(typ.PkgPath())
+
+	switch {
+	case strings.Contains(path, "mysql"):
+		return MySQLDialect
+	case strings.Contains(path, "sqlite"):
+		return SQLiteDialect
+	default:
+		return PostgresDialect
+	}
+}
+
+// Exec renders the statement for the connection's target server and executes it with its bound
+// arguments. A statement rendering nothing for the server, e.g. a table comment on SQLite, is
+// skipped.
+func Exec(ctx context.Context, db Execer, stmt Statement) (sql.Result, error) {
+	query, args, err := BuildFor(stmt, TargetOf(db))
+	if err != nil {
+		return nil, fmt.Errorf("render %T: %w", stmt, err)
+	}
+	if query == "" {
+		return driver.RowsAffected(0), nil
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, &ExecError{Builder: fmt.Sprintf("%T", stmt), Statement: query, Err: err}
+	}
+	return result, nil
+}
+
+// ExecAll executes the statements in order, stopping at the first error
+func ExecAll(ctx context.Context, db Execer, stmts ...Statement) error {
+	for _, stmt := range stmts {
+		if _, err := Exec(ctx, db, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Query runs a query and scans every row into a map keyed by column name. Values of
+// columns defined on the table are converted according to their DataType; other
+// columns are returned as scanned, with []byte converted to string.
+func Query(ctx context.Context, db Queryer, table *Table, query string, args ...any) ([]map[string]any, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, &ExecError{Builder: "query", Statement: query, Err: err}
+	}
+	defer rows.Close()
+
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]*Column, len(names))
+	if table != nil {
+		for i, name := range names {
+			columns[i] = table.column(name)
+		}
+	}
+
+	var result []map[string]any
+	for rows.Next() {
+		values := make([]any, len(names))
+		pointers := make([]any, len(names))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]any, len(names))
+		for i, name := range names {
+			value, err := convertValue(columns[i], values[i])
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", name, err)
+			}
+			row[name] = value
+		}
+		result = append(result, row)
+	}
+
+	return result, rows.Err()
+}
+
+// timeLayouts are the textual time formats returned by drivers that do not parse times
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// convertValue converts a scanned value to the Go type matching the column's DataType
+func convertValue(col *Column, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if b, ok := value.([]byte); ok {
+		value = string(b)
+	}
+	if col == nil {
+		return value, nil
+	}
+
+	switch col.DataType {
+	case SerialType, IntegerType:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case float64:
+			return int64(v), nil
+		case string:
+			return strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		}
+	case DecimalType:
+		// Decimals are kept as strings so that no precision is lost to a float
+		switch v := value.(type) {
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case string:
+			if !numericPattern.MatchString(strings.TrimSpace(v)) {
+				return nil, fmt.Errorf("cannot parse %q as %s", v, col.DataType)
+			}
+			return strings.TrimSpace(v), nil
+		}
+	case BooleanType:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		case string:
+			return strconv.ParseBool(strings.TrimSpace(v))
+		}
+	case DateType, DateTimeType:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			for _, layout := range timeLayouts {
+				if t, err := time.Parse(layout, v); err == nil {
+					return t, nil
+				}
+			}
+			return nil, fmt.Errorf("cannot parse %q as %s", v, col.DataType)
+		}
+	case StringType:
+		return fmt.Sprintf("%v", value), nil
+	default:
+		return value, nil
+	}
+
+	return nil, fmt.Errorf("cannot convert %T to %s", value, col.DataType)
+}
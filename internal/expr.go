@@ -0,0 +1,380 @@
+package gomb
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PlaceholderStyle selects how bound arguments are written in the SQL text
+type PlaceholderStyle int
+
+// QuestionPlaceholder and DollarPlaceholder are the defaults of the built-in dialects.
+// NamedPlaceholder and AtPlaceholder serve drivers of other databases (e.g. Oracle or SQL
+// Server) and are only used when set with SetPlaceholder or BuildExpr.
+const (
+	QuestionPlaceholder PlaceholderStyle = iota + 1 // ?
+	DollarPlaceholder                               // $1, $2, ...
+	NamedPlaceholder                                // :name, arguments are sql.NamedArg
+	AtPlaceholder                                   // @p1, @p2, ...
+)
+
+// Placeholder returns the default placeholder style of the dialect: $1 for PostgreSQL, ? for
+// MySQL and SQLite
+func (d Dialect) Placeholder() PlaceholderStyle {
+	if d == PostgresDialect {
+		return DollarPlaceholder
+	}
+	return QuestionPlaceholder
+}
+
+// Expr is a SQL expression that may carry values
+type Expr interface {
+	writeExpr(w *exprWriter)
+}
+
+// exprWriter renders expressions, collecting bound arguments or inlining them as literals
+type exprWriter struct {
+	builder strings.Builder
+	style   PlaceholderStyle
+	inline  bool    // DDL statements cannot take bind parameters, so values are inlined
+	dialect Dialect // Dialect of inlined string literals
+	args    []any
+	names   map[string]int
+	err     error // First problem found while rendering, e.g. a placeholder without an argument
+}
+
+func newExprWriter(style PlaceholderStyle) *exprWriter {
+	return &exprWriter{style: style, names: map[string]int{}}
+}
+
+// newInlineWriter creates a writer rendering values as literals escaped for the dialect
+func newInlineWriter(dialect Dialect) *exprWriter {
+	w := newExprWriter(QuestionPlaceholder)
+	w.inline = true
+	w.dialect = dialect
+	return w
+}
+
+func (w *exprWriter) write(s string) {
+	w.builder.WriteString(s)
+}
+
+// fail records a rendering problem; only the first one is kept
+func (w *exprWriter) fail(err error) {
+	if w.err == nil {
+		w.err = err
+	}
+}
+
+// bind writes a placeholder for the value, or the value as a literal when inlining
+func (w *exprWriter) bind(name string, value any) {
+	if e, ok := value.(Expr); ok {
+		e.writeExpr(w)
+		return
+	}
+	if w.inline {
+		w.write(QuoteLiteralFor(w.dialect, value))
+		return
+	}
+
+	n := len(w.args) + 1
+	switch w.style {
+	case DollarPlaceholder:
+		w.write("$" + strconv.Itoa(n))
+		w.args = append(w.args, value)
+	case AtPlaceholder:
+		w.write("@p" + strconv.Itoa(n))
+		w.args = append(w.args, value)
+	case NamedPlaceholder:
+		name = parameterName(name, n)
+		w.names[name]++
+		if count := w.names[name]; count > 1 {
+			name = fmt.Sprintf("%s_%d", name, count)
+		}
+		w.write(":" + name)
+		w.args = append(w.args, sql.Named(name, value))
+	default:
+		w.write("?")
+		w.args = append(w.args, value)
+	}
+}
+
+// parameterName turns a column reference such as u.id into a valid parameter name (u_id),
+// falling back to pN for the n-th argument
+func parameterName(name string, n int) string {
+	sanitized := []byte(name)
+	for i, c := range sanitized {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			sanitized[i] = '_'
+		}
+	}
+	name = strings.Trim(string(sanitized), "_")
+	if name == "" {
+		return "p" + strconv.Itoa(n)
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "p" + name
+	}
+	return name
+}
+
+// BuildExpr renders an expression with the given placeholder style
+func BuildExpr(e Expr, style PlaceholderStyle) (string, []any, error) {
+	w := newExprWriter(style)
+	e.writeExpr(w)
+	if w.err != nil {
+		return "", nil, w.err
+	}
+	return w.builder.String(), w.args, nil
+}
+
+// InlineExpr renders an expression with its values as escaped standard SQL literals, for DDL
+// statements such as index predicates and CHECK constraints
+func InlineExpr(e Expr) string {
+	return InlineExprFor(PostgresDialect, e)
+}
+
+// InlineExprFor renders an expression with its values as literals escaped for the dialect
+func InlineExprFor(dialect Dialect, e Expr) string {
+	w := newInlineWriter(dialect)
+	e.writeExpr(w)
+	return w.builder.String()
+}
+
+// QuoteLiteral renders a Go value as a standard SQL literal, escaping strings
+func QuoteLiteral(value any) string {
+	return QuoteLiteralFor(PostgresDialect, value)
+}
+
+// QuoteLiteralFor renders a Go value as a SQL literal, escaping strings for the dialect
+func QuoteLiteralFor(dialect Dialect, value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return QuoteStringFor(dialect, v.Format("2006-01-02 15:04:05.999999999Z07:00"))
+	case []byte:
+		return QuoteStringFor(dialect, string(v))
+	default:
+		return QuoteStringFor(dialect, fmt.Sprintf("%v", v))
+	}
+}
+
+// QuoteString renders a standard SQL string literal, doubling embedded single quotes. Use
+// QuoteStringFor for MySQL, where backslashes are escape characters.
+func QuoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// QuoteStringFor renders a string literal for the dialect. MySQL treats backslashes as escapes,
+// so they are doubled as well; under NO_BACKSLASH_ESCAPES the value then keeps both backslashes,
+// but the literal cannot be terminated early. PostgreSQL and SQLite take backslashes literally.
+func QuoteStringFor(dialect Dialect, s string) string {
+	if dialect == MySQLDialect {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return QuoteString(s)
+}
+
+type columnExpr string
+
+func (c columnExpr) writeExpr(w *exprWriter) { w.write(string(c)) }
+
+// Col references a column (or any identifier) in an expression
+func Col(name string) Expr {
+	return columnExpr(name)
+}
+
+type valueExpr struct {
+	name  string
+	value any
+}
+
+func (v valueExpr) writeExpr(w *exprWriter) { w.bind(v.name, v.value) }
+
+// Val binds a value in an expression
+func Val(value any) Expr {
+	return valueExpr{value: value}
+}
+
+// Named binds a value with an explicit name, used by the NamedPlaceholder style
+func Named(name string, value any) Expr {
+	return valueExpr{name: name, value: value}
+}
+
+type rawExpr struct {
+	sql  string
+	args []any
+}
+
+func (r rawExpr) writeExpr(w *exprWriter) {
+	next := 0
+	inQuote := false
+	for _, ch := range r.sql {
+		switch {
+		case ch == '\'':
+			inQuote = !inQuote
+			w.builder.WriteRune(ch)
+		case ch == '?' && !inQuote && next < len(r.args):
+			w.bind("", r.args[next])
+			next++
+		case ch == '?' && !inQuote:
+			w.builder.WriteRune(ch)
+			next++
+		default:
+			w.builder.WriteRune(ch)
+		}
+	}
+	if next != len(r.args) {
+		w.fail(fmt.Errorf("raw SQL %q has %d placeholders but %d arguments", r.sql, next, len(r.args)))
+	}
+}
+
+// Raw embeds SQL text; each ? outside string literals is replaced by the next argument. The
+// number of arguments must match the placeholders, which Build checks
+func Raw(sql string, args ...any) Expr {
+	return rawExpr{sql: sql, args: args}
+}
+
+type binaryExpr struct {
+	column   string
+	operator string
+	value    any
+}
+
+func (b binaryExpr) writeExpr(w *exprWriter) {
+	w.write(b.column + " " + b.operator + " ")
+	w.bind(b.column, b.value)
+}
+
+// Eq compares a column with a value (or expression) using =
+func Eq(column string, value any) Expr { return binaryExpr{column, "=", value} }
+
+// Ne compares a column with a value (or expression) using <>
+func Ne(column string, value any) Expr { return binaryExpr{column, "<>", value} }
+
+// Lt compares a column with a value (or expression) using <
+func Lt(column string, value any) Expr { return binaryExpr{column, "<", value} }
+
+// Le compares a column with a value (or expression) using <=
+func Le(column string, value any) Expr { return binaryExpr{column, "<=", value} }
+
+// Gt compares a column with a value (or expression) using >
+func Gt(column string, value any) Expr { return binaryExpr{column, ">", value} }
+
+// Ge compares a column with a value (or expression) using >=
+func Ge(column string, value any) Expr { return binaryExpr{column, ">=", value} }
+
+// Like matches a column against a pattern
+func Like(column string, pattern any) Expr { return binaryExpr{column, "LIKE", pattern} }
+
+type inExpr struct {
+	column string
+	values []any
+}
+
+func (in inExpr) writeExpr(w *exprWriter) {
+	if len(in.values) == 0 {
+		// IN () is invalid SQL; no row matches an empty list
+		w.write("FALSE")
+		return
+	}
+	w.write(in.column + " IN (")
+	for i, value := range in.values {
+		if i > 0 {
+			w.write(", ")
+		}
+		w.bind(in.column, value)
+	}
+	w.write(")")
+}
+
+// In checks that a column is one of the values; an empty list matches nothing and renders FALSE
+func In(column string, values ...any) Expr {
+	return inExpr{column: column, values: values}
+}
+
+type betweenExpr struct {
+	column    string
+	low, high any
+}
+
+func (b betweenExpr) writeExpr(w *exprWriter) {
+	w.write(b.column + " BETWEEN ")
+	w.bind(b.column, b.low)
+	w.write(" AND ")
+	w.bind(b.column, b.high)
+}
+
+// Between checks that a column lies within an inclusive range
+func Between(column string, low, high any) Expr {
+	return betweenExpr{column: column, low: low, high: high}
+}
+
+// IsNull checks that a column is NULL
+func IsNull(column string) Expr { return columnExpr(column + " IS NULL") }
+
+// IsNotNull checks that a column is not NULL
+func IsNotNull(column string) Expr { return columnExpr(column + " IS NOT NULL") }
+
+type logicalExpr struct {
+	operator string
+	exprs    []Expr
+}
+
+func (l logicalExpr) writeExpr(w *exprWriter) {
+	if len(l.exprs) == 0 {
+		// Empty conjunctions are true, empty disjunctions are false
+		if l.operator == "AND" {
+			w.write("TRUE")
+		} else {
+			w.write("FALSE")
+		}
+		return
+	}
+	if len(l.exprs) == 1 {
+		l.exprs[0].writeExpr(w)
+		return
+	}
+	w.write("(")
+	for i, e := range l.exprs {
+		if i > 0 {
+			w.write(" " + l.operator + " ")
+		}
+		e.writeExpr(w)
+	}
+	w.write(")")
+}
+
+// And combines expressions with AND
+func And(exprs ...Expr) Expr { return logicalExpr{operator: "AND", exprs: exprs} }
+
+// Or combines expressions with OR
+func Or(exprs ...Expr) Expr { return logicalExpr{operator: "OR", exprs: exprs} }
+
+type notExpr struct {
+	expr Expr
+}
+
+func (n notExpr) writeExpr(w *exprWriter) {
+	w.write("NOT (")
+	n.expr.writeExpr(w)
+	w.write(")")
+}
+
+// Not negates an expression
+func Not(e Expr) Expr { return notExpr{expr: e} }
@@ -0,0 +1,210 @@
+package gomb
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// TableNamer can be implemented by structs to override the generated table name
+type TableNamer interface {
+	TableName() string
+}
+
+// nullableTypes maps database/sql null wrappers to their data type
+var nullableTypes = map[reflect.Type]DataType{
+	reflect.TypeOf(sql.NullString{}):  StringType,
+	reflect.TypeOf(sql.NullInt16{}):   IntegerType,
+	reflect.TypeOf(sql.NullInt32{}):   IntegerType,
+	reflect.TypeOf(sql.NullInt64{}):   IntegerType,
+	reflect.TypeOf(sql.NullFloat64{}): DecimalType,
+	reflect.TypeOf(sql.NullBool{}):    BooleanType,
+	reflect.TypeOf(sql.NullTime{}):    DateTimeType,
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// TableFromStruct builds a Table definition from the exported fields of a struct.
+// Fields are configured with `gomb:"name,type=string,length=50,notnull,pk,unique,default=..."`
+// tags; a tag of "-" skips the field. default= takes the rest of the tag, commas included, so
+// it must be the last option. Pointer and sql.Null* fields are declared NULL, other fields
+// NOT NULL (implied for primary keys).
+func TableFromStruct(v any) (*Table, error) {
+	typ := reflect.TypeOf(v)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %T", v)
+	}
+
+	name := toSnakeCase(typ.Name())
+	if namer, ok := v.(TableNamer); ok {
+		name = namer.TableName()
+	}
+
+	table := NewTable(name)
+	if err := addStructColumns(table, typ); err != nil {
+		return nil, err
+	}
+
+	if len(table.Columns) == 0 {
+		return nil, fmt.Errorf("struct %s has no mappable fields", typ.Name())
+	}
+
+	return table, nil
+}
+
+// addStructColumns appends a column for every mapped field, flattening embedded structs
+func addStructColumns(table *Table, typ reflect.Type) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, hasTag := field.Tag.Lookup("gomb")
+		if tag == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		if field.Anonymous && !hasTag {
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+				if err := addStructColumns(table, fieldType); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		column, err := columnFromField(field, tag)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		table.AddColumn(column)
+	}
+	return nil
+}
+
+// columnFromField builds a column from a struct field and its gomb tag
+func columnFromField(field reflect.StructField, tag string) (*Column, error) {
+	options := strings.Split(tag, ",")
+
+	name := strings.TrimSpace(options[0])
+	if name == "" {
+		name = toSnakeCase(field.Name)
+	}
+	column := NewColumn(name)
+
+	dataType, nullable, err := dataTypeOf(field.Type)
+	if err != nil && !strings.Contains(tag, "type=") {
+		return nil, err
+	}
+	column.SetDataType(dataType)
+
+	for i := 1; i < len(options); i++ {
+		key, value, _ := strings.Cut(strings.TrimSpace(options[i]), "=")
+		switch key {
+		case "":
+			continue
+		case "type":
+			column.SetDataType(DataType(value))
+		case "length", "precision", "scale":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", key, value)
+			}
+			switch key {
+			case "length":
+				column.SetLength(n)
+			case "precision":
+				column.SetPrecision(n)
+			case "scale":
+				column.SetScale(n)
+			}
+		case "notnull":
+			if nullable {
+				return nil, fmt.Errorf("nullable field type %s cannot be notnull", field.Type)
+			}
+			column.SetNotNull()
+		case "pk":
+			column.SetPrimaryKey()
+		case "unique":
+			column.SetUnique()
+		case "default":
+			_, value, _ = strings.Cut(strings.Join(options[i:], ","), "=")
+			column.SetDefault(value)
+			i = len(options)
+		case "references":
+			column.References = value
+		case "comment":
+			column.SetComment(value)
+		default:
+			return nil, fmt.Errorf("unknown gomb tag option: %s", key)
+		}
+	}
+
+	switch {
+	case nullable:
+		column.SetNullable()
+	case !column.PrimaryKey:
+		column.SetNotNull()
+	}
+	return column, nil
+}
+
+// dataTypeOf maps a Go type to a DataType and reports whether it is nullable
+func dataTypeOf(typ reflect.Type) (DataType, bool, error) {
+	nullable := false
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+		nullable = true
+	}
+
+	if dataType, ok := nullableTypes[typ]; ok {
+		return dataType, true, nil
+	}
+
+	if typ == timeType {
+		return DateTimeType, nullable, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return StringType, nullable, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return IntegerType, nullable, nil
+	case reflect.Float32, reflect.Float64:
+		return DecimalType, nullable, nil
+	case reflect.Bool:
+		return BooleanType, nullable, nil
+	default:
+		return "", nullable, fmt.Errorf("unsupported field type %s, set type= in the gomb tag", typ)
+	}
+}
+
+// toSnakeCase converts a Go identifier such as UserID to user_id
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var builder strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				builder.WriteByte('_')
+			}
+			builder.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
@@ -0,0 +1,451 @@
+package gomb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ArgMode is the mode of a function or procedure argument
+type ArgMode string
+
+const (
+	InArg       ArgMode = "IN"
+	OutArg      ArgMode = "OUT"
+	InOutArg    ArgMode = "INOUT"
+	VariadicArg ArgMode = "VARIADIC"
+)
+
+// Volatility tells the planner how a function's result may change
+type Volatility string
+
+const (
+	Immutable Volatility = "IMMUTABLE"
+	Stable    Volatility = "STABLE"
+	Volatile  Volatility = "VOLATILE"
+)
+
+// FunctionArg is an argument of a function or procedure
+type FunctionArg struct {
+	Mode       ArgMode
+	Name       string
+	Type       DataType
+	Default    any // Rendered as a literal; expressions are inlined
+	HasDefault bool
+}
+
+// routine holds what functions and procedures have in common
+type routine struct {
+	name            string
+	schema          string
+	orReplace       bool
+	args            []FunctionArg
+	language        string
+	body            string
+	securityDefiner bool
+}
+
+// signature renders name(arg, ...)
+func (r *routine) signature() string {
+	args := make([]string, len(r.args))
+	for i, arg := range r.args {
+		var parts []string
+		if arg.Mode != "" && arg.Mode != InArg {
+			parts = append(parts, string(arg.Mode))
+		}
+		if arg.Name != "" {
+			parts = append(parts, arg.Name)
+		}
+		parts = append(parts, typeSQL(arg.Type))
+		if arg.HasDefault {
+			parts = append(parts, "DEFAULT "+literalList([]any{arg.Default}))
+		}
+		args[i] = strings.Join(parts, " ")
+	}
+	return qualify(r.schema, r.name) + "(" + strings.Join(args, ", ") + ")"
+}
+
+func (r *routine) validate(kind string) error {
+	if r.name == "" {
+		return ErrRequired.at("", "", "name", kind+" name is required")
+	}
+	if strings.TrimSpace(r.body) == "" {
+		return ErrRequired.at("", "", "body", kind+" body is required")
+	}
+	if r.language == "" {
+		return ErrRequired.at("", "", "language", kind+" language is required")
+	}
+
+	defaults := false
+	for _, arg := range r.args {
+		switch {
+		case arg.Type == "":
+			return ErrRequired.at("", "", "args", fmt.Sprintf("argument %s needs a type", arg.Name))
+		case arg.HasDefault && arg.Mode == OutArg:
+			return ErrInvalidOption.at("", "", "args", fmt.Sprintf("OUT argument %s cannot have a default", arg.Name))
+		case arg.HasDefault:
+			defaults = true
+		case defaults && arg.Mode != OutArg:
+			return ErrInvalidOption.at("", "", "args", fmt.Sprintf("argument %s must have a default because a previous argument has one", arg.Name))
+		}
+	}
+	return nil
+}
+
+// writeCreate renders CREATE [OR REPLACE] FUNCTION|PROCEDURE name(args)
+func (r *routine) writeCreate(sql *strings.Builder, kind string) {
+	sql.WriteString("CREATE ")
+	if r.orReplace {
+		sql.WriteString("OR REPLACE ")
+	}
+	sql.WriteString(kind + " " + r.signature())
+}
+
+// writeBody renders LANGUAGE, SECURITY DEFINER and the dollar-quoted body
+func (r *routine) writeBody(sql *strings.Builder) {
+	sql.WriteString(" LANGUAGE " + r.language)
+	if r.securityDefiner {
+		sql.WriteString(" SECURITY DEFINER")
+	}
+	sql.WriteString(" AS " + dollarQuote(r.body))
+}
+
+// typeSQL renders a type from the DataType catalogue, passing other types (TEXT, TRIGGER,
+// SETOF users, ...) through unchanged
+func typeSQL(dataType DataType) string {
+	if dataType == SerialType {
+		// SERIAL is only valid for columns
+		return "INTEGER"
+	}
+	if validDataTypes[dataType] {
+		return (&Column{}).ToDataTypeString(dataType)
+	}
+	return string(dataType)
+}
+
+// Function represents a CREATE FUNCTION statement (PostgreSQL)
+type Function struct {
+	routine
+	returns    DataType
+	volatility Volatility
+}
+
+// NewFunction creates a new function builder; the language defaults to plpgsql
+func NewFunction(name string) *Function {
+	return &Function{routine: routine{name: name, language: "plpgsql"}}
+}
+
+// SetSchema sets the schema of the function
+func (f *Function) SetSchema(schema string) *Function {
+	f.schema = schema
+	return f
+}
+
+// SetOrReplace adds OR REPLACE to the statement
+func (f *Function) SetOrReplace() *Function {
+	f.orReplace = true
+	return f
+}
+
+// AddArg adds an input argument
+func (f *Function) AddArg(name string, dataType DataType) *Function {
+	f.args = append(f.args, FunctionArg{Name: name, Type: dataType})
+	return f
+}
+
+// AddArgWithDefault adds an input argument with a default value
+func (f *Function) AddArgWithDefault(name string, dataType DataType, value any) *Function {
+	f.args = append(f.args, FunctionArg{Name: name, Type: dataType, Default: value, HasDefault: true})
+	return f
+}
+
+// AddArgWithMode adds an argument with an explicit mode (OUT, INOUT, VARIADIC)
+func (f *Function) AddArgWithMode(mode ArgMode, name string, dataType DataType) *Function {
+	f.args = append(f.args, FunctionArg{Mode: mode, Name: name, Type: dataType})
+	return f
+}
+
+// SetReturns sets the return type, either from the DataType catalogue or verbatim, e.g. "TRIGGER"
+func (f *Function) SetReturns(returns DataType) *Function {
+	f.returns = returns
+	return f
+}
+
+// SetLanguage sets the language of the body, e.g. "sql" or "plpgsql"
+func (f *Function) SetLanguage(language string) *Function {
+	f.language = language
+	return f
+}
+
+// SetVolatility marks the function IMMUTABLE, STABLE or VOLATILE
+func (f *Function) SetVolatility(volatility Volatility) *Function {
+	f.volatility = volatility
+	return f
+}
+
+// SetSecurityDefiner runs the function with the privileges of its owner
+func (f *Function) SetSecurityDefiner() *Function {
+	f.securityDefiner = true
+	return f
+}
+
+// SetBody sets the function body; it is dollar-quoted, so it needs no escaping
+func (f *Function) SetBody(body string) *Function {
+	f.body = body
+	return f
+}
+
+// ToSQL generates the CREATE FUNCTION statement
+func (f *Function) ToSQL() (string, error) {
+	if err := f.validate("function"); err != nil {
+		return "", err
+	}
+	if f.returns == "" {
+		return "", ErrRequired.at("", "", "returns", "function return type is required")
+	}
+	switch f.volatility {
+	case "", Immutable, Stable, Volatile:
+	default:
+		return "", ErrInvalidOption.at("", "", "volatility", fmt.Sprintf("invalid volatility: %s", f.volatility))
+	}
+
+	var sql strings.Builder
+	f.writeCreate(&sql, "FUNCTION")
+	sql.WriteString(" RETURNS " + typeSQL(f.returns))
+	if f.volatility != "" {
+		sql.WriteString(" " + string(f.volatility))
+	}
+	f.writeBody(&sql)
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (f *Function) IsStatement() {}
+
+// Build renders the CREATE FUNCTION statement for the given dialect
+func (f *Function) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "functions"); err != nil {
+		return "", nil, err
+	}
+	sql, err := f.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// Procedure represents a CREATE PROCEDURE statement (PostgreSQL 11+)
+type Procedure struct {
+	routine
+}
+
+// NewProcedure creates a new procedure builder; the language defaults to plpgsql
+func NewProcedure(name string) *Procedure {
+	return &Procedure{routine: routine{name: name, language: "plpgsql"}}
+}
+
+// SetSchema sets the schema of the procedure
+func (p *Procedure) SetSchema(schema string) *Procedure {
+	p.schema = schema
+	return p
+}
+
+// SetOrReplace adds OR REPLACE to the statement
+func (p *Procedure) SetOrReplace() *Procedure {
+	p.orReplace = true
+	return p
+}
+
+// AddArg adds an input argument
+func (p *Procedure) AddArg(name string, dataType DataType) *Procedure {
+	p.args = append(p.args, FunctionArg{Name: name, Type: dataType})
+	return p
+}
+
+// AddArgWithDefault adds an input argument with a default value
+func (p *Procedure) AddArgWithDefault(name string, dataType DataType, value any) *Procedure {
+	p.args = append(p.args, FunctionArg{Name: name, Type: dataType, Default: value, HasDefault: true})
+	return p
+}
+
+// AddArgWithMode adds an argument with an explicit mode (OUT, INOUT, VARIADIC)
+func (p *Procedure) AddArgWithMode(mode ArgMode, name string, dataType DataType) *Procedure {
+	p.args = append(p.args, FunctionArg{Mode: mode, Name: name, Type: dataType})
+	return p
+}
+
+// SetLanguage sets the language of the body, e.g. "sql" or "plpgsql"
+func (p *Procedure) SetLanguage(language string) *Procedure {
+	p.language = language
+	return p
+}
+
+// SetSecurityDefiner runs the procedure with the privileges of its owner
+func (p *Procedure) SetSecurityDefiner() *Procedure {
+	p.securityDefiner = true
+	return p
+}
+
+// SetBody sets the procedure body; it is dollar-quoted, so it needs no escaping
+func (p *Procedure) SetBody(body string) *Procedure {
+	p.body = body
+	return p
+}
+
+// ToSQL generates the CREATE PROCEDURE statement
+func (p *Procedure) ToSQL() (string, error) {
+	if err := p.validate("procedure"); err != nil {
+		return "", err
+	}
+
+	var sql strings.Builder
+	p.writeCreate(&sql, "PROCEDURE")
+	p.writeBody(&sql)
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (p *Procedure) IsStatement() {}
+
+// Build renders the CREATE PROCEDURE statement for the given dialect
+func (p *Procedure) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "procedures"); err != nil {
+		return "", nil, err
+	}
+	sql, err := p.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the CREATE PROCEDURE statement for the target server
+func (p *Procedure) BuildFor(target Target) (string, []any, error) {
+	if err := target.require(featureProcedures, "", "", "procedure"); err != nil {
+		return "", nil, err
+	}
+	return p.Build(target.Dialect)
+}
+
+// DropFunction represents a DROP FUNCTION or DROP PROCEDURE statement
+type DropFunction struct {
+	name      string
+	schema    string
+	argTypes  []DataType
+	procedure bool
+	ifExists  bool
+	cascade   bool
+	restrict  bool
+}
+
+// NewDropFunction creates a drop builder for the function with the given argument types
+func NewDropFunction(name string, argTypes ...DataType) *DropFunction {
+	return &DropFunction{name: name, argTypes: argTypes}
+}
+
+// NewDropProcedure creates a drop builder for the procedure with the given argument types
+func NewDropProcedure(name string, argTypes ...DataType) *DropFunction {
+	return &DropFunction{name: name, argTypes: argTypes, procedure: true}
+}
+
+// SetSchema sets the schema of the function
+func (df *DropFunction) SetSchema(schema string) *DropFunction {
+	df.schema = schema
+	return df
+}
+
+// SetIfExists adds IF EXISTS to the drop statement
+func (df *DropFunction) SetIfExists() *DropFunction {
+	df.ifExists = true
+	return df
+}
+
+// SetCascade adds CASCADE to the drop statement
+func (df *DropFunction) SetCascade() *DropFunction {
+	df.cascade = true
+	df.restrict = false
+	return df
+}
+
+// SetRestrict adds RESTRICT to the drop statement
+func (df *DropFunction) SetRestrict() *DropFunction {
+	df.restrict = true
+	df.cascade = false
+	return df
+}
+
+// ToSQL generates the DROP FUNCTION or DROP PROCEDURE statement
+func (df *DropFunction) ToSQL() (string, error) {
+	if df.name == "" {
+		return "", ErrRequired.at("", "", "name", "function name is required")
+	}
+
+	types := make([]string, len(df.argTypes))
+	for i, argType := range df.argTypes {
+		types[i] = typeSQL(argType)
+	}
+
+	var sql strings.Builder
+	if df.procedure {
+		sql.WriteString("DROP PROCEDURE ")
+	} else {
+		sql.WriteString("DROP FUNCTION ")
+	}
+	if df.ifExists {
+		sql.WriteString("IF EXISTS ")
+	}
+	sql.WriteString(qualify(df.schema, df.name) + "(" + strings.Join(types, ", ") + ")")
+	if df.cascade {
+		sql.WriteString(" CASCADE")
+	}
+	if df.restrict {
+		sql.WriteString(" RESTRICT")
+	}
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (df *DropFunction) IsStatement() {}
+
+// Build renders the DROP FUNCTION statement for the given dialect
+func (df *DropFunction) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "functions"); err != nil {
+		return "", nil, err
+	}
+	sql, err := df.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// dollarQuote wraps a body in $$ ... $$, or in a tagged $gombN$ quote if the body
+// itself contains $$
+func dollarQuote(body string) string {
+	tag := "$$"
+	for n := 0; strings.Contains(body, tag); n++ {
+		tag = "$gomb" + strconv.Itoa(n) + "$"
+	}
+	return tag + body + tag
+}
+
+// TouchUpdatedAt returns the function and BEFORE UPDATE trigger that set the column (by
+// default updated_at) to the current timestamp whenever a row of the table is updated
+func TouchUpdatedAt(table *Table, column string) (*Function, *Trigger, error) {
+	if table == nil || table.Name == "" {
+		return nil, nil, ErrRequired.at("", "", "table", "table is required")
+	}
+	if column == "" {
+		column = "updated_at"
+	}
+	col := table.column(column)
+	if col == nil {
+		return nil, nil, ErrRequired.at(table.Name, column, "", fmt.Sprintf("table %s has no column %s", table.Name, column))
+	}
+	if col.DataType != DateTimeType && col.DataType != DateType {
+		return nil, nil, ErrInvalidDataType.at(table.Name, column, "data_type", fmt.Sprintf("column %s must be a date or timestamp to be touched", column))
+	}
+
+	function := NewFunction(fmt.Sprintf("touch_%s_%s", table.Name, column)).
+		SetOrReplace().
+		SetReturns("TRIGGER").
+		SetBody(fmt.Sprintf("\nBEGIN\n    NEW.%s = CURRENT_TIMESTAMP;\n    RETURN NEW;\nEND;\n", column))
+
+	trigger := NewTrigger(fmt.Sprintf("trg_%s_%s", table.Name, column), table.Name).
+		SetBefore(UpdateEvent).
+		SetForEachRow().
+		SetFunction(function.name)
+
+	return function, trigger, nil
+}
@@ -1,32 +1,253 @@
 package gomb
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 )
 
+// IndexMethod is the access method of an index
+type IndexMethod string
+
+const (
+	BTreeIndex    IndexMethod = "btree"
+	HashIndex     IndexMethod = "hash"
+	GistIndex     IndexMethod = "gist"     // PostgreSQL
+	GinIndex      IndexMethod = "gin"      // PostgreSQL
+	BrinIndex     IndexMethod = "brin"     // PostgreSQL
+	SPGistIndex   IndexMethod = "spgist"   // PostgreSQL
+	FullTextIndex IndexMethod = "fulltext" // MySQL
+	SpatialIndex  IndexMethod = "spatial"  // MySQL
+)
+
+// indexMethods lists the index methods each dialect supports; SQLite only builds b-trees
+var indexMethods = map[Dialect][]IndexMethod{
+	PostgresDialect: {BTreeIndex, HashIndex, GistIndex, GinIndex, BrinIndex, SPGistIndex},
+	MySQLDialect:    {BTreeIndex, HashIndex, FullTextIndex, SpatialIndex},
+	SQLiteDialect:   {BTreeIndex},
+}
+
 // Index represents a database index
 type Index struct {
-	name           string
-	table          string
-	columns        []string
-	unique         bool
-	concurrently   bool
-	using          string
-	where          string
-	schema         string
-	includeColumns []string
-	method         string // btree, hash, gist, gin, etc.
-	tablespace     string
-	withOptions    []string
+	name             string
+	table            string
+	columns          []IndexColumn
+	unique           bool
+	concurrently     bool
+	ifNotExists      bool
+	only             bool
+	nullsNotDistinct bool
+	where            string
+	schema           string
+	includeColumns   []string
+	method           IndexMethod
+	tablespace       string
+	withOptions      []string
+}
+
+// SortOrder is the sort direction of an index column
+type SortOrder string
+
+const (
+	Ascending  SortOrder = "ASC"
+	Descending SortOrder = "DESC"
+)
+
+// NullsOrder is where NULLs sort in an index column (PostgreSQL)
+type NullsOrder string
+
+const (
+	NullsFirst NullsOrder = "FIRST"
+	NullsLast  NullsOrder = "LAST"
+)
+
+// IndexColumn is a column or expression of an index key, e.g. created_at DESC NULLS LAST
+type IndexColumn struct {
+	Name       string     `json:"name,omitempty"`
+	Expression string     `json:"expression,omitempty"` // Rendered in parentheses, e.g. lower(email)
+	Collation  string     `json:"collation,omitempty"`
+	OpClass    string     `json:"opclass,omitempty"` // Operator class, e.g. text_pattern_ops (PostgreSQL)
+	Order      SortOrder  `json:"order,omitempty"`
+	Nulls      NullsOrder `json:"nulls,omitempty"` // PostgreSQL
+}
+
+// NewIndexColumn creates an index key on the named column
+func NewIndexColumn(name string) *IndexColumn {
+	return &IndexColumn{Name: name}
+}
+
+// NewIndexExpression creates an index key on an expression, e.g. lower(email)
+func NewIndexExpression(expression string) *IndexColumn {
+	return &IndexColumn{Expression: expression}
+}
+
+// SetCollation sets the collation of the key
+func (ic *IndexColumn) SetCollation(collation string) *IndexColumn {
+	ic.Collation = collation
+	return ic
+}
+
+// SetOpClass sets the operator class of the key, e.g. text_pattern_ops or jsonb_path_ops
+func (ic *IndexColumn) SetOpClass(opClass string) *IndexColumn {
+	ic.OpClass = opClass
+	return ic
+}
+
+// SetAsc sorts the key in ascending order
+func (ic *IndexColumn) SetAsc() *IndexColumn {
+	ic.Order = Ascending
+	return ic
+}
+
+// SetDesc sorts the key in descending order
+func (ic *IndexColumn) SetDesc() *IndexColumn {
+	ic.Order = Descending
+	return ic
+}
+
+// SetNullsFirst sorts NULLs before non-null values
+func (ic *IndexColumn) SetNullsFirst() *IndexColumn {
+	ic.Nulls = NullsFirst
+	return ic
+}
+
+// SetNullsLast sorts NULLs after non-null values
+func (ic *IndexColumn) SetNullsLast() *IndexColumn {
+	ic.Nulls = NullsLast
+	return ic
+}
+
+// ToSQL generates the index key definition
+func (ic IndexColumn) ToSQL() (string, error) {
+	var sql string
+	switch {
+	case ic.Name != "" && ic.Expression != "":
+		return "", ErrInvalidOption.at("", ic.Name, "expression", "an index key takes either a column name or an expression")
+	case ic.Name != "":
+		sql = ic.Name
+	case ic.Expression != "":
+		sql = "(" + ic.Expression + ")"
+	default:
+		return "", ErrRequired.at("", "", "columns", "index key needs a column name or an expression")
+	}
+
+	if ic.Collation != "" {
+		sql += " COLLATE " + ic.Collation
+	}
+	if ic.OpClass != "" {
+		sql += " " + ic.OpClass
+	}
+	switch ic.Order {
+	case "":
+	case Ascending, Descending:
+		sql += " " + string(ic.Order)
+	default:
+		return "", ErrInvalidOption.at("", ic.Name, "order", fmt.Sprintf("invalid sort order: %s", ic.Order))
+	}
+	switch ic.Nulls {
+	case "":
+	case NullsFirst, NullsLast:
+		sql += " NULLS " + string(ic.Nulls)
+	default:
+		return "", ErrInvalidOption.at("", ic.Name, "nulls", fmt.Sprintf("invalid nulls order: %s", ic.Nulls))
+	}
+	return sql, nil
+}
+
+// MarshalJSON encodes a key that is a plain column name as a string, anything else as an object
+func (ic IndexColumn) MarshalJSON() ([]byte, error) {
+	if ic == (IndexColumn{Name: ic.Name}) {
+		return json.Marshal(ic.Name)
+	}
+	type indexColumn IndexColumn
+	return json.Marshal(indexColumn(ic))
+}
+
+// UnmarshalJSON accepts either an IndexColumn object or a plain column name
+func (ic *IndexColumn) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*ic = IndexColumn{Name: name}
+		return nil
+	}
+
+	type indexColumn IndexColumn
+	var col indexColumn
+	if err := json.Unmarshal(data, &col); err != nil {
+		return err
+	}
+	*ic = IndexColumn(col)
+	return nil
+}
+
+// indexDefinition is the serialized form of an Index
+type indexDefinition struct {
+	Name             string        `json:"name"`
+	Table            string        `json:"table"`
+	Columns          []IndexColumn `json:"columns"`
+	Unique           bool          `json:"unique,omitempty"`
+	Concurrently     bool          `json:"concurrently,omitempty"`
+	IfNotExists      bool          `json:"if_not_exists,omitempty"`
+	Only             bool          `json:"only,omitempty"`
+	NullsNotDistinct bool          `json:"nulls_not_distinct,omitempty"`
+	Where            string        `json:"where,omitempty"`
+	Schema           string        `json:"schema,omitempty"`
+	IncludeColumns   []string      `json:"include_columns,omitempty"`
+	Method           IndexMethod   `json:"method,omitempty"`
+	Tablespace       string        `json:"tablespace,omitempty"`
+	WithOptions      []string      `json:"with_options,omitempty"`
+}
+
+// MarshalJSON encodes the index definition as JSON
+func (idx *Index) MarshalJSON() ([]byte, error) {
+	return json.Marshal(indexDefinition{
+		Name:             idx.name,
+		Table:            idx.table,
+		Columns:          idx.columns,
+		Unique:           idx.unique,
+		Concurrently:     idx.concurrently,
+		IfNotExists:      idx.ifNotExists,
+		Only:             idx.only,
+		NullsNotDistinct: idx.nullsNotDistinct,
+		Where:            idx.where,
+		Schema:           idx.schema,
+		IncludeColumns:   idx.includeColumns,
+		Method:           idx.method,
+		Tablespace:       idx.tablespace,
+		WithOptions:      idx.withOptions,
+	})
+}
+
+// UnmarshalJSON decodes an index definition from JSON
+func (idx *Index) UnmarshalJSON(data []byte) error {
+	var def indexDefinition
+	if err := json.Unmarshal(data, &def); err != nil {
+		return err
+	}
+
+	*idx = *NewIndex(def.Name)
+	idx.table = def.Table
+	idx.columns = append(idx.columns, def.Columns...)
+	idx.unique = def.Unique
+	idx.concurrently = def.Concurrently
+	idx.ifNotExists = def.IfNotExists
+	idx.only = def.Only
+	idx.nullsNotDistinct = def.NullsNotDistinct
+	idx.where = def.Where
+	idx.schema = def.Schema
+	idx.includeColumns = def.IncludeColumns
+	idx.method = def.Method
+	idx.tablespace = def.Tablespace
+	idx.withOptions = def.WithOptions
+	return nil
 }
 
 // NewIndex creates a new index builder
 func NewIndex(name string) *Index {
 	return &Index{
 		name:    name,
-		columns: []string{},
-		using:   "btree", // Default to btree
+		columns: []IndexColumn{},
 	}
 }
 
@@ -38,7 +259,17 @@ func (idx *Index) OnTable(table string) *Index {
 
 // AddColumn adds a column to the index
 func (idx *Index) AddColumn(column string) *Index {
-	idx.columns = append(idx.columns, column)
+	idx.columns = append(idx.columns, IndexColumn{Name: column})
+	return idx
+}
+
+// AddIndexColumn adds keys with a sort order, collation or operator class to the index
+func (idx *Index) AddIndexColumn(columns ...*IndexColumn) *Index {
+	for _, column := range columns {
+		if column != nil {
+			idx.columns = append(idx.columns, *column)
+		}
+	}
 	return idx
 }
 
@@ -54,9 +285,27 @@ func (idx *Index) SetConcurrently() *Index {
 	return idx
 }
 
-// SetMethod sets the index method (btree, hash, gist, gin, etc.)
-func (idx *Index) SetMethod(method string) *Index {
-	idx.method = method
+// SetIfNotExists adds IF NOT EXISTS to the statement
+func (idx *Index) SetIfNotExists() *Index {
+	idx.ifNotExists = true
+	return idx
+}
+
+// SetOnly indexes only the parent of a partitioned table, without recursing into partitions (PostgreSQL)
+func (idx *Index) SetOnly() *Index {
+	idx.only = true
+	return idx
+}
+
+// SetNullsNotDistinct makes a unique index treat NULLs as equal (PostgreSQL 15+)
+func (idx *Index) SetNullsNotDistinct() *Index {
+	idx.nullsNotDistinct = true
+	return idx
+}
+
+// SetMethod sets the index method; it is checked against the dialect when the index is built
+func (idx *Index) SetMethod(method IndexMethod) *Index {
+	idx.method = IndexMethod(strings.ToLower(string(method)))
 	return idx
 }
 
@@ -66,6 +315,13 @@ func (idx *Index) SetWhere(condition string) *Index {
 	return idx
 }
 
+// SetWhereExpr adds a where clause built with the expression API. Index predicates
+// cannot take bind parameters, so values are rendered as escaped literals.
+func (idx *Index) SetWhereExpr(condition Expr) *Index {
+	idx.where = InlineExpr(condition)
+	return idx
+}
+
 // SetSchema sets the schema for the index
 func (idx *Index) SetSchema(schema string) *Index {
 	idx.schema = schema
@@ -92,16 +348,40 @@ func (idx *Index) AddWithOption(option string) *Index {
 
 // ToSQL generates the SQL for creating the index
 func (idx *Index) ToSQL() (string, error) {
+	return idx.render(PostgresDialect)
+}
+
+// render generates the CREATE INDEX statement for the dialect. CONCURRENTLY is dropped outside
+// PostgreSQL, which is the only dialect with a concurrent build; other options the dialect
+// lacks are rejected
+func (idx *Index) render(dialect Dialect) (string, error) {
 	if idx.name == "" {
-		return "", fmt.Errorf("index name is required")
+		return "", ErrRequired.at(idx.table, "", "name", "index name is required")
 	}
 
 	if idx.table == "" {
-		return "", fmt.Errorf("table name is required")
+		return "", ErrRequired.at("", "", "table", "table name is required")
 	}
 
 	if len(idx.columns) == 0 {
-		return "", fmt.Errorf("at least one column is required for an index")
+		return "", ErrRequired.at(idx.table, "", "columns", "at least one column is required for an index")
+	}
+
+	if idx.nullsNotDistinct && !idx.unique {
+		return "", ErrInvalidOption.at(idx.table, "", "nulls_not_distinct", "NULLS NOT DISTINCT requires a unique index")
+	}
+
+	if err := idx.checkDialect(dialect); err != nil {
+		return "", err
+	}
+
+	keys := make([]string, len(idx.columns))
+	for i, column := range idx.columns {
+		key, err := column.ToSQL()
+		if err != nil {
+			return "", inTable(err, idx.table)
+		}
+		keys[i] = key
 	}
 
 	var sql strings.Builder
@@ -112,15 +392,28 @@ func (idx *Index) ToSQL() (string, error) {
 		sql.WriteString("UNIQUE ")
 	}
 
+	// MySQL spells full-text and spatial indexes as index kinds rather than methods
+	if idx.method == FullTextIndex || idx.method == SpatialIndex {
+		sql.WriteString(strings.ToUpper(string(idx.method)) + " ")
+	}
+
 	sql.WriteString("INDEX ")
 
-	if idx.concurrently {
+	if idx.concurrently && dialect == PostgresDialect {
 		sql.WriteString("CONCURRENTLY ")
 	}
 
+	if idx.ifNotExists {
+		sql.WriteString("IF NOT EXISTS ")
+	}
+
 	sql.WriteString(idx.name)
 	sql.WriteString(" ON ")
 
+	if idx.only {
+		sql.WriteString("ONLY ")
+	}
+
 	if idx.schema != "" {
 		sql.WriteString(idx.schema)
 		sql.WriteString(".")
@@ -128,24 +421,28 @@ func (idx *Index) ToSQL() (string, error) {
 
 	sql.WriteString(idx.table)
 
-	if idx.method != "" {
+	if idx.method != "" && dialect == PostgresDialect {
 		sql.WriteString(" USING ")
-		sql.WriteString(idx.method)
+		sql.WriteString(string(idx.method))
 	}
 
 	sql.WriteString(" (")
-	sql.WriteString(strings.Join(idx.columns, ", "))
+	sql.WriteString(strings.Join(keys, ", "))
 	sql.WriteString(")")
 
+	if (idx.method == BTreeIndex || idx.method == HashIndex) && dialect == MySQLDialect {
+		sql.WriteString(" USING ")
+		sql.WriteString(strings.ToUpper(string(idx.method)))
+	}
+
 	if len(idx.includeColumns) > 0 {
 		sql.WriteString(" INCLUDE (")
 		sql.WriteString(strings.Join(idx.includeColumns, ", "))
 		sql.WriteString(")")
 	}
 
-	if idx.where != "" {
-		sql.WriteString(" WHERE ")
-		sql.WriteString(idx.where)
+	if idx.nullsNotDistinct {
+		sql.WriteString(" NULLS NOT DISTINCT")
 	}
 
 	if len(idx.withOptions) > 0 {
@@ -159,12 +456,63 @@ func (idx *Index) ToSQL() (string, error) {
 		sql.WriteString(idx.tablespace)
 	}
 
+	if idx.where != "" {
+		sql.WriteString(" WHERE ")
+		sql.WriteString(idx.where)
+	}
+
 	return sql.String(), nil
 }
 
+// checkDialect rejects index methods and options the dialect does not support
+func (idx *Index) checkDialect(dialect Dialect) error {
+	if err := validateDialect(dialect); err != nil {
+		return err
+	}
+	if idx.method != "" && !slices.Contains(indexMethods[dialect], idx.method) {
+		return ErrUnsupportedDialect.at(idx.table, "", "method", fmt.Sprintf("index method %s is not supported by %s", idx.method, dialect))
+	}
+	if idx.unique && (idx.method == FullTextIndex || idx.method == SpatialIndex) {
+		return ErrInvalidOption.at(idx.table, "", "method", fmt.Sprintf("%s indexes cannot be unique", idx.method))
+	}
+
+	var collation, opClass, nulls bool
+	for _, column := range idx.columns {
+		collation = collation || column.Collation != ""
+		opClass = opClass || column.OpClass != ""
+		nulls = nulls || column.Nulls != ""
+	}
+
+	postgres := []Dialect{PostgresDialect}
+	options := []struct {
+		set      bool
+		field    string
+		option   string
+		dialects []Dialect
+	}{
+		{idx.ifNotExists, "if_not_exists", "IF NOT EXISTS", []Dialect{PostgresDialect, SQLiteDialect}},
+		{idx.only, "only", "ONLY", postgres},
+		{len(idx.includeColumns) > 0, "include_columns", "INCLUDE", postgres},
+		{idx.nullsNotDistinct, "nulls_not_distinct", "NULLS NOT DISTINCT", postgres},
+		{len(idx.withOptions) > 0, "with_options", "WITH", postgres},
+		{idx.tablespace != "", "tablespace", "TABLESPACE", postgres},
+		{idx.where != "", "where", "WHERE", []Dialect{PostgresDialect, SQLiteDialect}},
+		{collation, "columns", "COLLATE", []Dialect{PostgresDialect, SQLiteDialect}},
+		{opClass, "columns", "operator classes", postgres},
+		{nulls, "columns", "NULLS FIRST/LAST", postgres},
+	}
+	for _, o := range options {
+		if o.set && !slices.Contains(o.dialects, dialect) {
+			return ErrUnsupportedDialect.at(idx.table, "", o.field, fmt.Sprintf("%s is not supported by %s", o.option, dialect))
+		}
+	}
+	return nil
+}
+
 // DropIndex represents a DROP INDEX operation
 type DropIndex struct {
 	name         string
+	table        string
 	ifExists     bool
 	concurrently bool
 	cascade      bool
@@ -211,17 +559,39 @@ func (di *DropIndex) SetSchema(schema string) *DropIndex {
 	return di
 }
 
+// OnTable sets the table of the index, which MySQL requires
+func (di *DropIndex) OnTable(table string) *DropIndex {
+	di.table = table
+	return di
+}
+
 // ToSQL generates the SQL for dropping the index
 func (di *DropIndex) ToSQL() (string, error) {
+	return di.render(PostgresDialect)
+}
+
+// render generates DROP INDEX for the dialect. MySQL drops the index of a table with
+// DROP INDEX ... ON table; CONCURRENTLY is dropped outside PostgreSQL, as for CREATE INDEX
+func (di *DropIndex) render(dialect Dialect) (string, error) {
 	if di.name == "" {
-		return "", fmt.Errorf("index name is required")
+		return "", ErrRequired.at("", "", "name", "index name is required")
+	}
+	if err := di.checkDialect(dialect); err != nil {
+		return "", err
 	}
 
 	var sql strings.Builder
 
 	sql.WriteString("DROP INDEX ")
 
-	if di.concurrently {
+	if dialect == MySQLDialect {
+		sql.WriteString(di.name)
+		sql.WriteString(" ON ")
+		sql.WriteString(qualify(di.schema, di.table))
+		return sql.String(), nil
+	}
+
+	if di.concurrently && dialect == PostgresDialect {
 		sql.WriteString("CONCURRENTLY ")
 	}
 
@@ -245,10 +615,27 @@ func (di *DropIndex) ToSQL() (string, error) {
 	return sql.String(), nil
 }
 
+// checkDialect rejects the options the dialect does not support
+func (di *DropIndex) checkDialect(dialect Dialect) error {
+	if err := validateDialect(dialect); err != nil {
+		return err
+	}
+	switch {
+	case dialect == MySQLDialect && di.table == "":
+		return ErrRequired.at("", "", "table", "mysql drops an index of a table; set it with OnTable")
+	case dialect == MySQLDialect && di.ifExists:
+		return ErrUnsupportedDialect.at(di.table, "", "if_exists", "IF EXISTS is not supported by mysql")
+	case dialect != PostgresDialect && (di.cascade || di.restrict):
+		return ErrUnsupportedDialect.at(di.table, "", "cascade", fmt.Sprintf("CASCADE and RESTRICT are not supported by %s", dialect))
+	}
+	return nil
+}
+
 // RenameIndex represents a RENAME INDEX operation
 type RenameIndex struct {
 	oldName string
 	newName string
+	table   string
 	schema  string
 }
 
@@ -266,10 +653,36 @@ func (ri *RenameIndex) SetSchema(schema string) *RenameIndex {
 	return ri
 }
 
+// OnTable sets the table of the index, which MySQL requires
+func (ri *RenameIndex) OnTable(table string) *RenameIndex {
+	ri.table = table
+	return ri
+}
+
 // ToSQL generates the SQL for renaming the index
 func (ri *RenameIndex) ToSQL() (string, error) {
+	return ri.render(PostgresDialect)
+}
+
+// render generates ALTER INDEX ... RENAME TO, or ALTER TABLE ... RENAME INDEX on MySQL.
+// SQLite cannot rename an index
+func (ri *RenameIndex) render(dialect Dialect) (string, error) {
 	if ri.oldName == "" || ri.newName == "" {
-		return "", fmt.Errorf("both old and new index names are required")
+		return "", ErrRequired.at("", "", "name", "both old and new index names are required")
+	}
+
+	switch dialect {
+	case PostgresDialect:
+	case MySQLDialect:
+		if ri.table == "" {
+			return "", ErrRequired.at("", "", "table", "mysql renames an index of a table; set it with OnTable")
+		}
+		return fmt.Sprintf("ALTER TABLE %s RENAME INDEX %s TO %s", qualify(ri.schema, ri.table), ri.oldName, ri.newName), nil
+	default:
+		if err := validateDialect(dialect); err != nil {
+			return "", err
+		}
+		return "", ErrUnsupportedDialect.at("", "", "dialect", fmt.Sprintf("renaming an index is not supported by %s", dialect))
 	}
 
 	var sql strings.Builder
@@ -288,55 +701,141 @@ func (ri *RenameIndex) ToSQL() (string, error) {
 	return sql.String(), nil
 }
 
+// ReindexTarget is the kind of object a REINDEX rebuilds
+type ReindexTarget string
+
+const (
+	ReindexIndex    ReindexTarget = "INDEX"
+	ReindexTable    ReindexTarget = "TABLE"
+	ReindexSchema   ReindexTarget = "SCHEMA"
+	ReindexDatabase ReindexTarget = "DATABASE"
+	ReindexSystem   ReindexTarget = "SYSTEM"
+)
+
 // ReindexOperation represents a REINDEX operation
 type ReindexOperation struct {
-	target       string // INDEX, TABLE, SCHEMA, DATABASE, SYSTEM
+	target       ReindexTarget
 	name         string
+	schema       string
 	concurrently bool
+	verbose      bool
+	tablespace   string
 }
 
 // NewReindex creates a new reindex builder
-func NewReindex(target, name string) *ReindexOperation {
+func NewReindex(target ReindexTarget, name string) *ReindexOperation {
 	return &ReindexOperation{
-		target: strings.ToUpper(target),
+		target: ReindexTarget(strings.ToUpper(string(target))),
 		name:   name,
 	}
 }
 
+// SetSchema sets the schema of the index or table
+func (ro *ReindexOperation) SetSchema(schema string) *ReindexOperation {
+	ro.schema = schema
+	return ro
+}
+
 // SetConcurrently sets the reindex to be done concurrently
 func (ro *ReindexOperation) SetConcurrently() *ReindexOperation {
 	ro.concurrently = true
 	return ro
 }
 
+// SetVerbose reports progress as each index is rebuilt
+func (ro *ReindexOperation) SetVerbose() *ReindexOperation {
+	ro.verbose = true
+	return ro
+}
+
+// SetTablespace rebuilds the indexes in a new tablespace
+func (ro *ReindexOperation) SetTablespace(tablespace string) *ReindexOperation {
+	ro.tablespace = tablespace
+	return ro
+}
+
 // ToSQL generates the SQL for the reindex operation
 func (ro *ReindexOperation) ToSQL() (string, error) {
-	if ro.target == "" {
-		return "", fmt.Errorf("reindex target is required")
+	return ro.render(PostgresDialect)
+}
+
+// render generates the REINDEX statement for the dialect. SQLite rebuilds a single index or
+// table without options; MySQL has no REINDEX
+func (ro *ReindexOperation) render(dialect Dialect) (string, error) {
+	if err := ro.validate(); err != nil {
+		return "", err
 	}
 
-	if ro.name == "" && ro.target != "SYSTEM" && ro.target != "DATABASE" {
-		return "", fmt.Errorf("name is required for REINDEX %s", ro.target)
+	switch dialect {
+	case PostgresDialect:
+	case SQLiteDialect:
+		if (ro.target != ReindexIndex && ro.target != ReindexTable) || ro.concurrently || ro.verbose || ro.tablespace != "" {
+			return "", ErrUnsupportedDialect.at("", "", "target", "sqlite only reindexes a single index or table, without options")
+		}
+		return "REINDEX " + qualify(ro.schema, ro.name), nil
+	default:
+		if err := validateDialect(dialect); err != nil {
+			return "", err
+		}
+		return "", ErrUnsupportedDialect.at("", "", "dialect", fmt.Sprintf("REINDEX is not supported by %s", dialect))
 	}
 
 	var sql strings.Builder
 
 	sql.WriteString("REINDEX ")
 
-	if ro.concurrently {
-		sql.WriteString("CONCURRENTLY ")
+	// VERBOSE and TABLESPACE only exist in the options list, which then carries CONCURRENTLY too
+	optionList := ro.verbose || ro.tablespace != ""
+	if optionList {
+		var options []string
+		if ro.verbose {
+			options = append(options, "VERBOSE")
+		}
+		if ro.concurrently {
+			options = append(options, "CONCURRENTLY")
+		}
+		if ro.tablespace != "" {
+			options = append(options, "TABLESPACE "+ro.tablespace)
+		}
+		sql.WriteString("(" + strings.Join(options, ", ") + ") ")
 	}
 
-	sql.WriteString(ro.target)
+	sql.WriteString(string(ro.target))
+
+	if ro.concurrently && !optionList {
+		sql.WriteString(" CONCURRENTLY")
+	}
 
 	if ro.name != "" {
 		sql.WriteString(" ")
-		sql.WriteString(ro.name)
+		sql.WriteString(qualify(ro.schema, ro.name))
 	}
 
 	return sql.String(), nil
 }
 
+func (ro *ReindexOperation) validate() error {
+	switch ro.target {
+	case ReindexIndex, ReindexTable, ReindexSchema, ReindexDatabase, ReindexSystem:
+	case "":
+		return ErrRequired.at("", "", "target", "reindex target is required")
+	default:
+		return ErrInvalidOption.at("", "", "target", fmt.Sprintf("invalid reindex target: %s", ro.target))
+	}
+
+	switch {
+	case ro.name == "" && ro.target != ReindexSystem && ro.target != ReindexDatabase:
+		return ErrRequired.at("", "", "name", fmt.Sprintf("name is required for REINDEX %s", ro.target))
+	case ro.schema != "" && ro.target != ReindexIndex && ro.target != ReindexTable:
+		return ErrInvalidOption.at("", "", "schema", fmt.Sprintf("REINDEX %s takes an unqualified name", ro.target))
+	case ro.target == ReindexSystem && ro.concurrently:
+		return ErrInvalidOption.at("", "", "concurrently", "system catalogs cannot be reindexed concurrently")
+	case ro.target == ReindexSystem && ro.tablespace != "":
+		return ErrInvalidOption.at("", "", "tablespace", "system catalogs cannot be moved to another tablespace")
+	}
+	return nil
+}
+
 // SetIndexTablespace represents an ALTER INDEX SET TABLESPACE operation
 type SetIndexTablespace struct {
 	indexName  string
@@ -368,11 +867,11 @@ func (sit *SetIndexTablespace) SetSchema(schema string) *SetIndexTablespace {
 // ToSQL generates the SQL for the set tablespace operation
 func (sit *SetIndexTablespace) ToSQL() (string, error) {
 	if sit.indexName == "" {
-		return "", fmt.Errorf("index name is required")
+		return "", ErrRequired.at("", "", "name", "index name is required")
 	}
 
 	if sit.tablespace == "" {
-		return "", fmt.Errorf("tablespace name is required")
+		return "", ErrRequired.at("", "", "tablespace", "tablespace name is required")
 	}
 
 	var sql strings.Builder
@@ -400,15 +899,19 @@ func (idx *Index) PartialIndex(condition string) *Index {
 	return idx.SetWhere(condition)
 }
 
-// ExpressionIndex adds an expression to the index instead of a simple column
+// ExpressionIndex adds an expression to the index instead of a simple column. The
+// expression is written as is, so anything but a function call must be parenthesized;
+// NewIndexExpression adds the parentheses itself
 func (idx *Index) ExpressionIndex(expression string) *Index {
-	idx.columns = append(idx.columns, expression)
+	idx.columns = append(idx.columns, IndexColumn{Name: expression})
 	return idx
 }
 
 // MultiColumnIndex adds multiple columns to the index at once
 func (idx *Index) MultiColumnIndex(columns ...string) *Index {
-	idx.columns = append(idx.columns, columns...)
+	for _, column := range columns {
+		idx.AddColumn(column)
+	}
 	return idx
 }
 
@@ -426,3 +929,86 @@ func (ro *ReindexOperation) IsStatement() {}
 
 // IsStatement implementation for SQL generation interface
 func (sit *SetIndexTablespace) IsStatement() {}
+
+// Build renders the CREATE INDEX statement for the given dialect
+func (idx *Index) Build(dialect Dialect) (string, []any, error) {
+	sql, err := idx.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the CREATE INDEX statement for the target server
+func (idx *Index) BuildFor(target Target) (string, []any, error) {
+	if err := idx.checkVersion(target); err != nil {
+		return "", nil, err
+	}
+	return idx.Build(target.Dialect)
+}
+
+// checkVersion fails when the target server predates an option of the index
+func (idx *Index) checkVersion(target Target) error {
+	if len(idx.includeColumns) > 0 {
+		if err := target.require(featureIndexInclude, idx.table, "", "include_columns"); err != nil {
+			return err
+		}
+	}
+	if idx.nullsNotDistinct {
+		if err := target.require(featureNullsNotDistinct, idx.table, "", "nulls_not_distinct"); err != nil {
+			return err
+		}
+	}
+	for _, column := range idx.columns {
+		if column.Expression != "" {
+			if err := target.require(featureFunctionalKeyParts, idx.table, "", "columns"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Build renders the DROP INDEX statement for the given dialect
+func (di *DropIndex) Build(dialect Dialect) (string, []any, error) {
+	sql, err := di.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// Build renders the index rename for the given dialect
+func (ri *RenameIndex) Build(dialect Dialect) (string, []any, error) {
+	sql, err := ri.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// Build renders the REINDEX statement for the given dialect
+func (ro *ReindexOperation) Build(dialect Dialect) (string, []any, error) {
+	sql, err := ro.render(dialect)
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the REINDEX statement for the target server
+func (ro *ReindexOperation) BuildFor(target Target) (string, []any, error) {
+	var required []feature
+	if ro.concurrently {
+		required = append(required, featureReindexConcurrently)
+	}
+	if ro.tablespace != "" || (ro.concurrently && ro.verbose) {
+		required = append(required, featureReindexOptionList)
+	}
+	if ro.name == "" {
+		required = append(required, featureReindexWithoutName)
+	}
+	for _, f := range required {
+		if err := target.require(f, "", "", "target"); err != nil {
+			return "", nil, err
+		}
+	}
+	return ro.Build(target.Dialect)
+}
+
+// Build renders the ALTER INDEX SET TABLESPACE statement, which only PostgreSQL supports
+func (sit *SetIndexTablespace) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "index tablespaces"); err != nil {
+		return "", nil, err
+	}
+	sql, err := sit.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
@@ -0,0 +1,331 @@
+package gomb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// catalogQueries holds the dialect specific queries used by Introspect.
+// Every dialect returns the same row shapes:
+//
+//	tables:      name
+//	columns:     name, type, length, precision, scale, nullable (YES/NO), default, identity (0/1)
+//	constraints: constraint name, constraint type, column, referenced table, referenced column
+//	indexes:     index name, column or expression, unique (0/1), method, expression (0/1)
+type catalogQueries struct {
+	tables      string
+	columns     string
+	constraints string
+	indexes     string
+}
+
+var catalogs = map[Dialect]catalogQueries{
+	PostgresDialect: {
+		tables: `SELECT table_name FROM information_schema.tables
+			WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' ORDER BY table_name`,
+		columns: `SELECT column_name,
+			CASE data_type WHEN 'USER-DEFINED' THEN udt_name WHEN 'ARRAY' THEN substr(udt_name, 2) || '[]' ELSE data_type END,
+			COALESCE(character_maximum_length, 0),
+			COALESCE(numeric_precision, 0), COALESCE(numeric_scale, 0), is_nullable, column_default,
+			CASE WHEN column_default LIKE 'nextval(%' OR is_identity = 'YES' THEN 1 ELSE 0 END
+			FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = $1 ORDER BY ordinal_position`,
+		constraints: `SELECT tc.constraint_name, tc.constraint_type, kcu.column_name, ccu.table_name, ccu.column_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.key_column_usage kcu
+				ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name
+			LEFT JOIN information_schema.constraint_column_usage ccu
+				ON tc.constraint_type = 'FOREIGN KEY' AND ccu.constraint_schema = tc.constraint_schema AND ccu.constraint_name = tc.constraint_name
+			WHERE tc.table_schema = current_schema() AND tc.table_name = $1
+			ORDER BY tc.constraint_name, kcu.ordinal_position`,
+		indexes: `SELECT i.relname, pg_catalog.pg_get_indexdef(ix.indexrelid, k.ord::int, true),
+				CASE WHEN ix.indisunique THEN 1 ELSE 0 END, am.amname, CASE WHEN k.attnum = 0 THEN 1 ELSE 0 END
+			FROM pg_catalog.pg_index ix
+			JOIN pg_catalog.pg_class t ON t.oid = ix.indrelid
+			JOIN pg_catalog.pg_class i ON i.oid = ix.indexrelid
+			JOIN pg_catalog.pg_am am ON am.oid = i.relam
+			JOIN pg_catalog.pg_namespace n ON n.oid = t.relnamespace
+			JOIN LATERAL unnest(ix.indkey) WITH ORDINALITY AS k(attnum, ord) ON true
+			WHERE n.nspname = current_schema() AND t.relname = $1 AND NOT ix.indisprimary
+				AND NOT EXISTS (SELECT 1 FROM pg_catalog.pg_constraint c WHERE c.conindid = ix.indexrelid)
+			ORDER BY i.relname, k.ord`,
+	},
+	MySQLDialect: {
+		tables: `SELECT table_name FROM information_schema.tables
+			WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' ORDER BY table_name`,
+		columns: `SELECT column_name, data_type, COALESCE(character_maximum_length, 0),
+			COALESCE(numeric_precision, 0), COALESCE(numeric_scale, 0), is_nullable, column_default,
+			CASE WHEN extra LIKE '%auto_increment%' THEN 1 ELSE 0 END
+			FROM information_schema.columns
+			WHERE table_schema = DATABASE() AND table_name = ? ORDER BY ordinal_position`,
+		constraints: `SELECT kcu.constraint_name, tc.constraint_type, kcu.column_name, kcu.referenced_table_name, kcu.referenced_column_name
+			FROM information_schema.key_column_usage kcu
+			JOIN information_schema.table_constraints tc
+				ON tc.constraint_schema = kcu.constraint_schema AND tc.table_name = kcu.table_name AND tc.constraint_name = kcu.constraint_name
+			WHERE kcu.table_schema = DATABASE() AND kcu.table_name = ?
+			ORDER BY kcu.constraint_name, kcu.ordinal_position`,
+		indexes: `SELECT s.index_name, s.column_name, CASE WHEN s.non_unique = 0 THEN 1 ELSE 0 END, LOWER(s.index_type), 0
+			FROM information_schema.statistics s
+			WHERE s.table_schema = DATABASE() AND s.table_name = ? AND s.index_name <> 'PRIMARY'
+				AND NOT EXISTS (SELECT 1 FROM information_schema.table_constraints tc
+					WHERE tc.table_schema = s.table_schema AND tc.table_name = s.table_name AND tc.constraint_name = s.index_name)
+			ORDER BY s.index_name, s.seq_in_index`,
+	},
+	SQLiteDialect: {
+		tables: `SELECT name FROM sqlite_master
+			WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`,
+		columns: `SELECT name, type, 0, 0, 0, CASE WHEN "notnull" = 1 THEN 'NO' ELSE 'YES' END, dflt_value, 0
+			FROM pragma_table_info(?1) ORDER BY cid`,
+		constraints: `SELECT 'pk', 'PRIMARY KEY', name, NULL, NULL FROM pragma_table_info(?1) WHERE pk > 0
+			UNION ALL
+			SELECT il.name, 'UNIQUE', ii.name, NULL, NULL FROM pragma_index_list(?1) il JOIN pragma_index_info(il.name) ii WHERE il.origin = 'u'
+			UNION ALL
+			SELECT 'fk_' || id, 'FOREIGN KEY', "from", "table", "to" FROM pragma_foreign_key_list(?1)`,
+		indexes: `SELECT il.name, ii.name, il."unique", '', 0
+			FROM pragma_index_list(?1) il JOIN pragma_index_info(il.name) ii
+			WHERE il.origin = 'c' ORDER BY il.name, ii.seqno`,
+	},
+}
+
+// Introspect reads the tables of the current schema (or database) and returns their
+// definitions, including columns, single-column constraints and indexes
+func Introspect(ctx context.Context, db *sql.DB, dialect Dialect) ([]*Table, error) {
+	if err := validateDialect(dialect); err != nil {
+		return nil, err
+	}
+	queries := catalogs[dialect]
+
+	names, err := queryTableNames(ctx, db, queries.tables)
+	if err != nil {
+		return nil, fmt.Errorf("introspect tables: %w", err)
+	}
+
+	tables := make([]*Table, 0, len(names))
+	for _, name := range names {
+		table := NewTable(name)
+		if err := introspectColumns(ctx, db, queries.columns, table); err != nil {
+			return nil, fmt.Errorf("introspect columns of %s: %w", name, err)
+		}
+		if err := introspectConstraints(ctx, db, queries.constraints, table); err != nil {
+			return nil, fmt.Errorf("introspect constraints of %s: %w", name, err)
+		}
+		if err := introspectIndexes(ctx, db, queries.indexes, table); err != nil {
+			return nil, fmt.Errorf("introspect indexes of %s: %w", name, err)
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func queryTableNames(ctx context.Context, db *sql.DB, query string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+func introspectColumns(ctx context.Context, db *sql.DB, query string, table *Table) error {
+	rows, err := db.QueryContext(ctx, query, table.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			name, typeName, nullable string
+			length, precision, scale int64
+			defaultValue             sql.NullString
+			identity                 int64
+		)
+		if err := rows.Scan(&name, &typeName, &length, &precision, &scale, &nullable, &defaultValue, &identity); err != nil {
+			return err
+		}
+
+		col := NewColumn(name)
+		dataType, typeLength, typePrecision, typeScale := parseSQLType(typeName)
+		if validDataTypes[dataType] {
+			col.SetDataType(dataType)
+		} else {
+			col.SetCustomType(strings.TrimSpace(typeName))
+		}
+		col.SetLength(firstNonZero(int(length), typeLength))
+		if dataType == DecimalType && isExactNumeric(typeName) {
+			col.SetPrecision(firstNonZero(int(precision), typePrecision))
+			col.SetScale(firstNonZero(int(scale), typeScale))
+		}
+		if identity == 1 {
+			col.SetDataType(SerialType)
+		} else if defaultValue.Valid {
+			col.Default = parseCatalogDefault(defaultValue.String)
+		}
+		if strings.EqualFold(nullable, "NO") {
+			col.SetNotNull()
+		}
+		table.AddColumn(col)
+	}
+	return rows.Err()
+}
+
+func introspectConstraints(ctx context.Context, db *sql.DB, query string, table *Table) error {
+	rows, err := db.QueryContext(ctx, query, table.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type constraint struct {
+		kind       string
+		columns    []string
+		references string
+	}
+	var order []string
+	constraints := map[string]*constraint{}
+
+	for rows.Next() {
+		var (
+			name, kind, column  string
+			refTable, refColumn sql.NullString
+		)
+		if err := rows.Scan(&name, &kind, &column, &refTable, &refColumn); err != nil {
+			return err
+		}
+		c, ok := constraints[name]
+		if !ok {
+			c = &constraint{kind: strings.ToUpper(kind)}
+			constraints[name] = c
+			order = append(order, name)
+		}
+		c.columns = append(c.columns, column)
+		if refTable.Valid && refColumn.Valid {
+			c.references = fmt.Sprintf("%s(%s)", refTable.String, refColumn.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Only single-column constraints can be expressed on Column
+	for _, name := range order {
+		c := constraints[name]
+		if len(c.columns) != 1 {
+			continue
+		}
+		col := table.column(c.columns[0])
+		if col == nil {
+			continue
+		}
+		switch c.kind {
+		case "PRIMARY KEY":
+			col.SetPrimaryKey()
+		case "UNIQUE":
+			col.SetUnique()
+		case "FOREIGN KEY":
+			col.References = c.references
+		}
+	}
+	return nil
+}
+
+func introspectIndexes(ctx context.Context, db *sql.DB, query string, table *Table) error {
+	rows, err := db.QueryContext(ctx, query, table.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	indexes := map[string]*Index{}
+	for rows.Next() {
+		var (
+			name, column       string
+			unique, expression int64
+			method             sql.NullString
+		)
+		if err := rows.Scan(&name, &column, &unique, &method, &expression); err != nil {
+			return err
+		}
+		idx, ok := indexes[name]
+		if !ok {
+			idx = NewIndex(name).OnTable(table.Name)
+			if unique == 1 {
+				idx.SetUnique()
+			}
+			if method.String != "" && method.String != "btree" {
+				idx.SetMethod(IndexMethod(method.String))
+			}
+			indexes[name] = idx
+			table.AddIndex(idx)
+		}
+		if expression == 1 {
+			idx.AddIndexColumn(NewIndexExpression(column))
+		} else {
+			idx.AddColumn(column)
+		}
+	}
+	return rows.Err()
+}
+
+var sqlTypePattern = regexp.MustCompile(`^\s*([a-zA-Z ]+?)\s*(?:\(\s*(\d+)\s*(?:,\s*(\d+)\s*)?\))?\s*$`)
+
+// parseSQLType maps a database type name (e.g. "character varying", "VARCHAR(50)",
+// "numeric(10,2)") to a DataType and any length, precision and scale it carries.
+// Unknown types are returned verbatim; Introspect keeps them as a CustomType.
+func parseSQLType(typeName string) (DataType, int, int, int) {
+	match := sqlTypePattern.FindStringSubmatch(typeName)
+	if match == nil {
+		return DataType(strings.ToLower(typeName)), 0, 0, 0
+	}
+	base := strings.ToLower(match[1])
+	first, _ := strconv.Atoi(match[2])
+	second, _ := strconv.Atoi(match[3])
+
+	switch base {
+	case "character varying", "varchar", "character", "char", "text", "nvarchar", "nchar", "clob":
+		return StringType, first, 0, 0
+	case "integer", "int", "int2", "int4", "int8", "smallint", "bigint", "mediumint", "tinyint":
+		return IntegerType, 0, 0, 0
+	case "serial", "bigserial", "smallserial":
+		return SerialType, 0, 0, 0
+	case "numeric", "decimal", "real", "double precision", "double", "float", "float4", "float8":
+		return DecimalType, 0, first, second
+	case "boolean", "bool":
+		return BooleanType, 0, 0, 0
+	case "date":
+		return DateType, 0, 0, 0
+	case "timestamp", "timestamp without time zone", "timestamp with time zone", "timestamptz", "datetime":
+		return DateTimeType, 0, 0, 0
+	default:
+		return DataType(base), 0, 0, 0
+	}
+}
+
+// isExactNumeric reports whether the type carries a meaningful decimal precision
+func isExactNumeric(typeName string) bool {
+	typeName = strings.ToLower(typeName)
+	return strings.HasPrefix(typeName, "numeric") || strings.HasPrefix(typeName, "decimal")
+}
+
+func firstNonZero(values ...int) int {
+	for _, v := range values {
+		if v != 0 {
+			return v
+		}
+	}
+	return 0
+}
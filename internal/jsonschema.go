@@ -0,0 +1,207 @@
+package gomb
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JSONSchemaID is the identifier of the generated metadata JSON Schema
+const JSONSchemaID = "https://github.com/nandrechetan/gomb/schema/gomb.schema.json"
+
+// schemaDefinitions lists the metadata structs described by the JSON Schema
+var schemaDefinitions = map[string]reflect.Type{
+	"Table":          reflect.TypeOf(Table{}),
+	"Column":         reflect.TypeOf(Column{}),
+	"ColumnUpdate":   reflect.TypeOf(ColumnUpdate{}),
+	"DefaultExpr":    reflect.TypeOf(DefaultExpr{}),
+	"Index":          reflect.TypeOf(indexDefinition{}),
+	"IndexColumn":    reflect.TypeOf(IndexColumn{}),
+	"PartitionBy":    reflect.TypeOf(PartitionBy{}),
+	"TableLike":      reflect.TypeOf(TableLike{}),
+	"TablePrivilege": reflect.TypeOf(TablePrivilege{}),
+	"TablePolicy":    reflect.TypeOf(TablePolicy{}),
+}
+
+// schemaAliases maps types whose serialized form is described by another definition
+var schemaAliases = map[reflect.Type]string{
+	reflect.TypeOf(Index{}): "Index",
+}
+
+// requiredProperties lists the properties that must be present for each definition
+var requiredProperties = map[string][]string{
+	"Table":          {"name"},
+	"Column":         {"name", "data_type"},
+	"DefaultExpr":    {"kind"},
+	"Index":          {"name", "table", "columns"},
+	"PartitionBy":    {"strategy", "columns"},
+	"TableLike":      {"source"},
+	"TablePrivilege": {"role", "privileges"},
+	"TablePolicy":    {"name"},
+}
+
+// JSONSchema generates a JSON Schema (draft 2020-12) document describing the
+// Table, Column, ColumnUpdate and Index metadata formats
+func JSONSchema() ([]byte, error) {
+	defs := map[string]any{}
+	for name, typ := range schemaDefinitions {
+		defs[name] = structSchema(name, typ)
+	}
+
+	schema := map[string]any{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"$id":         JSONSchemaID,
+		"title":       "gomb metadata",
+		"description": "Table, column and index definitions for the Go metadata builder",
+		"$ref":        "#/$defs/Table",
+		"$defs":       defs,
+	}
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// structSchema builds the object schema for a struct definition
+func structSchema(name string, typ reflect.Type) map[string]any {
+	properties := map[string]any{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldName := jsonFieldName(field)
+		if fieldName == "" {
+			continue
+		}
+		properties[fieldName] = propertySchema(fieldName, field.Type)
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+
+	if required := requiredProperties[name]; len(required) > 0 {
+		schema["required"] = required
+	}
+
+	if name == "Table" {
+		// CREATE TABLE ... AS and LIKE take their columns from the query or the source table
+		schema["anyOf"] = []any{
+			map[string]any{"required": []string{"columns"}},
+			map[string]any{"required": []string{"as_query"}},
+			map[string]any{"required": []string{"like"}},
+		}
+	}
+
+	if name == "Column" {
+		// JSON Schema cannot compare two properties, so precision >= scale is
+		// enforced by Column.Validate; the schema only requires precision with scale
+		schema["dependentRequired"] = map[string][]string{"scale": {"precision"}}
+	}
+
+	return schema
+}
+
+// propertySchema builds the schema for a single property
+func propertySchema(name string, typ reflect.Type) map[string]any {
+	switch typ {
+	case reflect.TypeOf(DataType("")):
+		return map[string]any{"type": "string", "enum": dataTypeNames()}
+	case reflect.TypeOf(PartitionStrategy("")):
+		return map[string]any{"type": "string", "enum": []PartitionStrategy{RangePartition, ListPartition, HashPartition}}
+	case reflect.TypeOf(Privilege("")):
+		return map[string]any{"type": "string", "enum": objectPrivileges[TableObject]}
+	case reflect.TypeOf(PolicyCommand("")):
+		return map[string]any{"type": "string", "enum": []PolicyCommand{AllCommand, SelectCommand, InsertCommand, UpdateCommand, DeleteCommand}}
+	case reflect.TypeOf(IndexMethod("")):
+		return map[string]any{"type": "string", "enum": []IndexMethod{BTreeIndex, HashIndex, GistIndex, GinIndex, BrinIndex, SPGistIndex, FullTextIndex, SpatialIndex}}
+	case reflect.TypeOf(SortOrder("")):
+		return map[string]any{"type": "string", "enum": []SortOrder{Ascending, Descending}}
+	case reflect.TypeOf(NullsOrder("")):
+		return map[string]any{"type": "string", "enum": []NullsOrder{NullsFirst, NullsLast}}
+	case reflect.TypeOf(IndexColumn{}):
+		// Plain strings are column names
+		return map[string]any{"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{"$ref": "#/$defs/IndexColumn"},
+		}}
+	case reflect.TypeOf(DefaultKind("")):
+		return map[string]any{"type": "string", "enum": []DefaultKind{LiteralDefault, FunctionDefault, RawDefault}}
+	case reflect.TypeOf(&DefaultExpr{}):
+		// Plain strings are accepted and interpreted like Column.SetDefault
+		return map[string]any{"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{"$ref": "#/$defs/DefaultExpr"},
+		}}
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		if def := definitionName(typ); def != "" {
+			return map[string]any{"$ref": "#/$defs/" + def}
+		}
+		return map[string]any{}
+	case reflect.Ptr:
+		if def := definitionName(typ.Elem()); def != "" {
+			return map[string]any{"$ref": "#/$defs/" + def}
+		}
+		return propertySchema(name, typ.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		schema := map[string]any{"type": "integer"}
+		switch name {
+		// identity_inc may be negative; 0 stands for an unset increment, so it is not constrained
+		case "length", "precision", "scale", "auto_number_start", "identity_start":
+			schema["minimum"] = 0
+		}
+		return schema
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": propertySchema(name, typ.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object"}
+	default:
+		return map[string]any{}
+	}
+}
+
+// definitionName returns the definition describing the given struct type, if any
+func definitionName(typ reflect.Type) string {
+	if name, ok := schemaAliases[typ]; ok {
+		return name
+	}
+	for name, def := range schemaDefinitions {
+		if def == typ {
+			return name
+		}
+	}
+	return ""
+}
+
+// jsonFieldName returns the JSON property name of a struct field, or "" if it is not serialized
+func jsonFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// dataTypeNames returns the sorted list of valid data types
+func dataTypeNames() []string {
+	names := make([]string, 0, len(validDataTypes))
+	for dataType := range validDataTypes {
+		names = append(names, string(dataType))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,305 @@
+package gomb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ChangeClass classifies the effect of a schema change on a live database
+type ChangeClass string
+
+const (
+	SafeChange         ChangeClass = "safe"         // Only updates the catalog
+	LockingChange      ChangeClass = "locking"      // Blocks reads or writes while it scans or rewrites the table
+	DestructiveChange  ChangeClass = "destructive"  // Breaks queries or removes guarantees, but keeps the data
+	IrreversibleChange ChangeClass = "irreversible" // Deletes data that a rollback cannot restore
+)
+
+// Severity is the level at which a lint rule is reported
+type Severity string
+
+const (
+	SeverityOff     Severity = "off"
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+var severityRanks = map[Severity]int{SeverityOff: 0, SeverityInfo: 1, SeverityWarning: 2, SeverityError: 3}
+
+// ParseSeverity parses off, info, warning or error
+func ParseSeverity(s string) (Severity, error) {
+	severity := Severity(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := severityRanks[severity]; !ok {
+		return "", ErrInvalidOption.at("", "", "severity", fmt.Sprintf("unknown severity %q", s))
+	}
+	return severity, nil
+}
+
+// AtLeast reports whether the severity is at or above min
+func (s Severity) AtLeast(min Severity) bool {
+	return severityRanks[s] >= severityRanks[min]
+}
+
+// LintRule is a check applied to schema changes
+type LintRule struct {
+	ID          string
+	Class       ChangeClass
+	Severity    Severity // Default severity
+	Description string
+}
+
+var lintRules = []LintRule{
+	{"drop_table", IrreversibleChange, SeverityError, "dropping a table deletes its data"},
+	{"drop_column", IrreversibleChange, SeverityError, "dropping a column deletes its data"},
+	{"drop_cascade", DestructiveChange, SeverityWarning, "CASCADE also drops the views, constraints and other objects depending on the dropped object"},
+	{"drop_constraint", DestructiveChange, SeverityWarning, "dropping a constraint lets invalid rows in"},
+	{"rename_column", DestructiveChange, SeverityWarning, "renaming a column breaks queries of the application versions still running"},
+	{"change_column_type", LockingChange, SeverityWarning, "changing the type of a column rewrites the table under an exclusive lock and may truncate values"},
+	{"add_column_constraint", LockingChange, SeverityWarning, "adding a column with UNIQUE, CHECK, a foreign key, a primary key, a generated value, a volatile default or NOT NULL without a default checks or rewrites the table under a lock"},
+	{"set_not_null", LockingChange, SeverityWarning, "SET NOT NULL scans the table under an exclusive lock unless a validated CHECK constraint proves it"},
+	{"add_constraint", LockingChange, SeverityWarning, "adding a constraint without NOT VALID checks existing rows under a lock"},
+	{"detach_partition", LockingChange, SeverityWarning, "detaching a partition without CONCURRENTLY locks the parent table"},
+	{"drop_index", LockingChange, SeverityWarning, "DROP INDEX without CONCURRENTLY blocks reads and writes on the table"},
+	{"truncate", IrreversibleChange, SeverityError, "truncating a table deletes all of its rows"},
+	{"drop_schema", IrreversibleChange, SeverityError, "dropping a schema with CASCADE, or a MySQL database, deletes every table in it"},
+}
+
+// LintRules returns the built-in rules with their default severities
+func LintRules() []LintRule {
+	return slices.Clone(lintRules)
+}
+
+func lintRule(id string) (LintRule, bool) {
+	for _, rule := range lintRules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+	return LintRule{}, false
+}
+
+// LintFinding is an unsafe operation reported by a Linter
+type LintFinding struct {
+	Rule      string
+	Class     ChangeClass
+	Severity  Severity
+	Statement int    // Index of the statement in the linted list
+	Line      int    // Line of the statement in LintSQL, 0 otherwise
+	Table     string // Table affected by the operation
+	Object    string // Column, constraint, partition or index affected by the operation
+	Message   string
+}
+
+// String formats the finding as "severity rule: table.object: message"
+func (f LintFinding) String() string {
+	target := f.Table
+	if f.Object != "" {
+		if target != "" {
+			target += "."
+		}
+		target += f.Object
+	}
+	return fmt.Sprintf("%s %s: %s: %s", f.Severity, f.Rule, target, f.Message)
+}
+
+// AllowedStatement is a statement annotated with the lint rules it is allowed to break
+type AllowedStatement struct {
+	Statement
+	Rules []string
+}
+
+// AllowLint annotates a statement so that the Linter skips the given rules for it; the
+// statement still builds and executes as before
+func AllowLint(stmt Statement, rules ...string) *AllowedStatement {
+	return &AllowedStatement{Statement: stmt, Rules: rules}
+}
+
+// BuildFor renders the annotated statement for the target server
+func (s *AllowedStatement) BuildFor(target Target) (string, []any, error) {
+	return BuildFor(s.Statement, target)
+}
+
+// Linter classifies the operations of AlterTable, DropTable, DropIndex, DropSchema and Truncate
+// statements and reports the unsafe ones
+type Linter struct {
+	target     Target
+	severities map[string]Severity
+	allowed    map[string]bool
+	errs       []error
+}
+
+// NewLinter creates a linter using the default severities of LintRules
+func NewLinter() *Linter {
+	return &Linter{severities: map[string]Severity{}, allowed: map[string]bool{}}
+}
+
+// SetTarget sets the server the statements run on, for the changes whose cost depends on its
+// version; the zero Target stands for the latest PostgreSQL
+func (l *Linter) SetTarget(target Target) *Linter {
+	l.target = target
+	return l
+}
+
+// SetSeverity overrides the severity of a rule; SeverityOff disables it
+func (l *Linter) SetSeverity(rule string, severity Severity) *Linter {
+	if _, ok := lintRule(rule); !ok {
+		l.errs = append(l.errs, ErrInvalidOption.at("", "", "rule", fmt.Sprintf("unknown lint rule %q", rule)))
+	} else if _, ok := severityRanks[severity]; !ok {
+		l.errs = append(l.errs, ErrInvalidOption.at("", "", "severity", fmt.Sprintf("unknown severity %q", severity)))
+	} else {
+		l.severities[rule] = severity
+	}
+	return l
+}
+
+// Allow skips the given rules for every statement
+func (l *Linter) Allow(rules ...string) *Linter {
+	for _, rule := range rules {
+		if _, ok := lintRule(rule); !ok {
+			l.errs = append(l.errs, ErrInvalidOption.at("", "", "rule", fmt.Sprintf("unknown lint rule %q", rule)))
+			continue
+		}
+		l.allowed[rule] = true
+	}
+	return l
+}
+
+// Errors returns the invalid rules and severities passed to the linter
+func (l *Linter) Errors() []error {
+	return l.errs
+}
+
+// Lint reports the unsafe operations of the statements. Statements other than AlterTable,
+// DropTable, DropIndex, DropSchema and Truncate are skipped.
+func (l *Linter) Lint(statements ...Statement) []LintFinding {
+	var findings []LintFinding
+	for i, stmt := range statements {
+		findings = append(findings, l.lintStatement(i, stmt, nil)...)
+	}
+	return findings
+}
+
+func (l *Linter) lintStatement(index int, stmt Statement, allowed []string) []LintFinding {
+	var findings []LintFinding
+	report := func(rule, table, object, message string) {
+		if l.allowed[rule] || slices.Contains(allowed, rule) {
+			return
+		}
+		definition, _ := lintRule(rule)
+		severity := definition.Severity
+		if override, ok := l.severities[rule]; ok {
+			severity = override
+		}
+		if severity == SeverityOff {
+			return
+		}
+		findings = append(findings, LintFinding{
+			Rule:      rule,
+			Class:     definition.Class,
+			Severity:  severity,
+			Statement: index,
+			Table:     table,
+			Object:    object,
+			Message:   message,
+		})
+	}
+
+	switch s := stmt.(type) {
+	case *AllowedStatement:
+		return l.lintStatement(index, s.Statement, append(slices.Clone(allowed), s.Rules...))
+	case *AlterTable:
+		for _, op := range s.Operations {
+			lintOperation(report, l.target, s.TableName, op)
+		}
+	case *DropTable:
+		for _, name := range append([]string{s.Name}, s.Names...) {
+			name = qualify(s.Schema, name)
+			report("drop_table", name, "", "dropping a table deletes its data")
+			if s.Cascade {
+				report("drop_cascade", name, "", "CASCADE also drops the views and foreign keys depending on the table")
+			}
+		}
+	case *DropIndex:
+		name := qualify(s.schema, s.name)
+		// Only PostgreSQL has CONCURRENTLY; MySQL drops indexes without blocking writes
+		if !s.concurrently && l.target.postgres() {
+			report("drop_index", "", name, "DROP INDEX without CONCURRENTLY blocks reads and writes on the table")
+		}
+		if s.cascade {
+			report("drop_cascade", "", name, "CASCADE also drops the constraints depending on the index")
+		}
+	case *DropSchema:
+		for _, name := range s.names {
+			if s.cascade {
+				report("drop_schema", "", name, "DROP SCHEMA ... CASCADE deletes every table in the schema")
+			} else if l.target.Dialect == MySQLDialect {
+				report("drop_schema", "", name, "dropping a MySQL database deletes every table in it")
+			}
+		}
+	case *Truncate:
+		for _, name := range s.tables {
+			message := "truncating a table deletes all of its rows"
+			if s.cascade {
+				message += "; CASCADE also truncates the tables referencing it"
+			}
+			report("truncate", qualify(s.schema, name), "", message)
+		}
+	}
+	return findings
+}
+
+// ClassifyOperation returns the class of a single ALTER TABLE operation
+func ClassifyOperation(op ColumnOperation) ChangeClass {
+	class := SafeChange
+	lintOperation(func(rule, _, _, _ string) {
+		definition, _ := lintRule(rule)
+		if lintClassRank(definition.Class) > lintClassRank(class) {
+			class = definition.Class
+		}
+	}, Target{}, "", op)
+	return class
+}
+
+func lintClassRank(class ChangeClass) int {
+	return slices.Index([]ChangeClass{SafeChange, LockingChange, DestructiveChange, IrreversibleChange}, class)
+}
+
+func lintOperation(report func(rule, table, object, message string), target Target, table string, op ColumnOperation) {
+	switch op.Operation {
+	case DropColumnOp:
+		report("drop_column", table, op.Column.Name, "dropping a column deletes its data")
+	case RenameColumnOp:
+		report("rename_column", table, op.Column.Name, fmt.Sprintf("renaming %s to %s breaks queries of the application versions still running", op.Column.Name, op.Column.UpdateOptions.Name))
+	case AlterColumnTypeOp:
+		report("change_column_type", table, op.Column.Name, fmt.Sprintf("changing the type to %s rewrites the table under an exclusive lock and may truncate values", op.Column.UpdateOptions.DataType))
+	case AddColumnOp:
+		col := op.Column
+		hasDefault := col.Default != nil && !col.Default.IsNull()
+		switch {
+		case col.Unique || col.Check != "" || col.References != "" || col.PrimaryKey || col.Generated != "" || col.AutoNumber:
+			report("add_column_constraint", table, col.Name, "the column constraints are checked or the table is rewritten under a lock; use the online Planner")
+		case col.Default.volatile():
+			report("add_column_constraint", table, col.Name, "a volatile default is evaluated for every row, rewriting the table under a lock; add the column without it and backfill")
+		case hasDefault && !target.supports(featureFastDefault):
+			report("add_column_constraint", table, col.Name, "adding a column with a default rewrites the table under a lock before PostgreSQL 11")
+		case col.NotNull && !hasDefault && target.postgres():
+			// MySQL fills existing rows with the implicit default of the type
+			report("add_column_constraint", table, col.Name, "a NOT NULL column without a default fails on a non-empty table; add it nullable and backfill")
+		}
+	case SetNotNullOp:
+		report("set_not_null", table, op.Column.Name, "SET NOT NULL scans the table under an exclusive lock unless a validated CHECK constraint proves it")
+	case AddConstraintOp:
+		definition := strings.ToUpper(op.Constraint.Definition)
+		if !op.Constraint.NotValid && !strings.Contains(definition, "USING INDEX") {
+			report("add_constraint", table, op.Constraint.Name, "existing rows are checked under a lock; add the constraint NOT VALID and validate it")
+		}
+	case DropConstraintOp:
+		report("drop_constraint", table, op.Constraint.Name, "dropping a constraint lets invalid rows in")
+	case DetachPartitionOp:
+		if !op.Partition.concurrently {
+			report("detach_partition", table, op.Partition.name, "detaching a partition without CONCURRENTLY locks the parent table")
+		}
+	}
+}
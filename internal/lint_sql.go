@@ -0,0 +1,489 @@
+package gomb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// lintAnnotation starts a comment allowing lint rules for the statement it precedes, or for the
+// statement ending on the same line, e.g. -- gomb:allow drop_column, drop_cascade
+const lintAnnotation = "gomb:allow"
+
+// sqlStatement is a statement of a migration script, without its comments
+type sqlStatement struct {
+	text    string
+	line    int
+	allowed []string
+}
+
+// LintSQL lints a migration script. ALTER TABLE, DROP TABLE, DROP INDEX, DROP SCHEMA (or DROP
+// DATABASE on MySQL) and TRUNCATE statements are read into builders; other statements and unrecognized ALTER TABLE clauses are skipped.
+// A "-- gomb:allow rule, ..." comment allows rules for the next statement, or for the
+// previous one when it follows it on the same line.
+func (l *Linter) LintSQL(sql string) ([]LintFinding, error) {
+	statements, err := splitSQL(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []LintFinding
+	for i, statement := range statements {
+		for _, rule := range statement.allowed {
+			if _, ok := lintRule(rule); !ok {
+				return nil, ErrInvalidOption.at("", "", "rule", fmt.Sprintf("line %d: unknown lint rule %q", statement.line, rule))
+			}
+		}
+		for _, stmt := range parseLintStatement(tokenizeSQL(statement.text)) {
+			for _, finding := range l.lintStatement(i, stmt, statement.allowed) {
+				finding.Line = statement.line
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings, nil
+}
+
+// splitSQL splits a script on semicolons outside quotes, comments and dollar-quoted bodies
+func splitSQL(sql string) ([]sqlStatement, error) {
+	var statements []sqlStatement
+	var current sqlStatement
+	var text strings.Builder
+	line, endLine := 1, 0
+
+	flush := func() {
+		current.text = strings.TrimSpace(text.String())
+		if current.text != "" {
+			statements = append(statements, current)
+			current, endLine = sqlStatement{}, line
+		}
+		text.Reset()
+	}
+	annotate := func(comment string, commentLine int) {
+		rules, ok := strings.CutPrefix(strings.TrimSpace(comment), lintAnnotation)
+		if !ok {
+			return
+		}
+		allowed := strings.FieldsFunc(rules, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+		if strings.TrimSpace(text.String()) == "" && commentLine == endLine && len(statements) > 0 {
+			last := &statements[len(statements)-1]
+			last.allowed = append(last.allowed, allowed...)
+			return
+		}
+		current.allowed = append(current.allowed, allowed...)
+	}
+	// quoted copies a quoted string or dollar-quoted body opened by delimiter at sql[i]
+	quoted := func(i int, delimiter, kind string) (int, error) {
+		if current.line == 0 {
+			current.line = line
+		}
+		start := i + len(delimiter)
+		end := strings.Index(sql[start:], delimiter)
+		if end < 0 {
+			return 0, ErrInvalidOption.at("", "", "sql", fmt.Sprintf("line %d: unterminated %s", line, kind))
+		}
+		end += start + len(delimiter)
+		text.WriteString(sql[i:end])
+		line += strings.Count(sql[i:end], "\n")
+		return end, nil
+	}
+
+	for i := 0; i < len(sql); {
+		var err error
+		switch c := sql[i]; {
+		case strings.HasPrefix(sql[i:], "--"):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			annotate(sql[i+2:i+end], line)
+			i += end
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return nil, ErrInvalidOption.at("", "", "sql", fmt.Sprintf("line %d: unterminated comment", line))
+			}
+			comment := sql[i+2 : i+2+end]
+			annotate(comment, line)
+			line += strings.Count(comment, "\n")
+			text.WriteByte(' ')
+			i += end + 4
+		case c == '\'':
+			i, err = quoted(i, "'", "quoted string")
+		case c == '"':
+			i, err = quoted(i, `"`, "quoted identifier")
+		case c == '`':
+			i, err = quoted(i, "`", "quoted identifier")
+		case c == '$' && dollarTag(sql[i:]) != "":
+			i, err = quoted(i, dollarTag(sql[i:]), "dollar-quoted string")
+		case c == ';':
+			flush()
+			i++
+		default:
+			if c == '\n' {
+				line++
+			} else if current.line == 0 && !unicode.IsSpace(rune(c)) {
+				current.line = line
+			}
+			text.WriteByte(c)
+			i++
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	flush()
+	return statements, nil
+}
+
+// dollarTag returns the $tag$ opening a dollar-quoted string at the start of s
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '$':
+			return s[:i+1]
+		case c == '_', unicode.IsLetter(rune(c)), i > 1 && unicode.IsDigit(rune(c)):
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// tokenizeSQL splits a statement into words, quoted strings, parenthesized groups and commas.
+// MySQL backtick quotes are removed from identifiers, e.g. `t`.`x` becomes t.x
+func tokenizeSQL(sql string) []string {
+	var tokens []string
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		start := i
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+			continue
+		case c == ',':
+			i++
+		case c == '(':
+			depth := 0
+			for ; i < len(sql); i++ {
+				switch sql[i] {
+				case '(':
+					depth++
+				case ')':
+					depth--
+				case '\'', '"':
+					if end := strings.IndexByte(sql[i+1:], sql[i]); end >= 0 {
+						i += end + 1
+					}
+				}
+				if depth == 0 {
+					i++
+					break
+				}
+			}
+		case c == '\'' || c == '"':
+			i++
+			if end := strings.IndexByte(sql[i:], c); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+		default:
+			for i < len(sql) && !unicode.IsSpace(rune(sql[i])) && !strings.ContainsRune(",()'", rune(sql[i])) {
+				if sql[i] == '`' {
+					if end := strings.IndexByte(sql[i+1:], '`'); end >= 0 {
+						i += end + 1
+					}
+				}
+				i++
+			}
+			tokens = append(tokens, strings.ReplaceAll(sql[start:i], "`", ""))
+			continue
+		}
+		tokens = append(tokens, sql[start:i])
+	}
+	return tokens
+}
+
+// parseLintStatement reads the ALTER TABLE, DROP TABLE, DROP INDEX, DROP SCHEMA, DROP DATABASE
+// and TRUNCATE statements that the Linter checks; any other statement yields nothing
+func parseLintStatement(tokens []string) []Statement {
+	words := sqlWords(tokens)
+	switch {
+	case words.match("DROP", "TABLE"):
+		ifExists := words.match("IF", "EXISTS")
+		names := words.list()
+		if len(names) == 0 {
+			return nil
+		}
+		drop := NewDropTable(names[0], names[1:]...).SetIfExists(ifExists)
+		if words.match("CASCADE") {
+			drop.SetCascade(true)
+		}
+		return []Statement{drop}
+	case words.match("DROP", "INDEX"):
+		concurrently := words.match("CONCURRENTLY")
+		words.match("IF", "EXISTS")
+		names := words.list()
+		var table string
+		if words.match("ON") {
+			table = words.next()
+		}
+		cascade := words.match("CASCADE")
+		var statements []Statement
+		for _, name := range names {
+			drop := NewDropIndex(name).OnTable(table)
+			if concurrently {
+				drop.SetConcurrently()
+			}
+			if cascade {
+				drop.SetCascade()
+			}
+			statements = append(statements, drop)
+		}
+		return statements
+	case words.match("DROP", "SCHEMA"), words.match("DROP", "DATABASE"):
+		drop := NewDropSchema()
+		if words.match("IF", "EXISTS") {
+			drop.SetIfExists()
+		}
+		drop.names = words.list()
+		if words.match("CASCADE") {
+			drop.SetCascade()
+		}
+		return []Statement{drop}
+	case words.match("TRUNCATE"):
+		words.match("TABLE")
+		words.match("ONLY")
+		var tables []string
+		for _, name := range words.list() {
+			tables = append(tables, strings.TrimSuffix(name, "*"))
+		}
+		truncate := NewTruncate(tables...)
+		for len(words) > 0 {
+			if words.match("CASCADE") {
+				truncate.SetCascade()
+			} else {
+				words.next()
+			}
+		}
+		return []Statement{truncate}
+	case words.match("ALTER", "TABLE"):
+		words.match("IF", "EXISTS")
+		words.match("ONLY")
+		table := words.next()
+		words.match("*")
+		alter := NewAlterTable(table)
+		for _, clause := range words.split() {
+			parseAlterClause(alter, clause)
+		}
+		if len(alter.Operations) == 0 {
+			return nil
+		}
+		return []Statement{alter}
+	}
+	return nil
+}
+
+// parseAlterClause adds the operation of an ALTER TABLE clause; unrecognized clauses are skipped
+func parseAlterClause(alter *AlterTable, words sqlWords) {
+	switch {
+	case words.match("ADD", "CONSTRAINT"):
+		name := words.next()
+		addConstraint(alter, name, words)
+	case words.matchAny("ADD", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "EXCLUDE"):
+		addConstraint(alter, "", words[1:])
+	case words.matchAny("ADD", "INDEX", "KEY", "FULLTEXT", "SPATIAL", "PARTITION"):
+		// MySQL builds secondary indexes in place without blocking writes; partitions are not
+		// checked
+	case words.match("ADD"):
+		words.match("COLUMN")
+		words.match("IF", "NOT", "EXISTS")
+		alter.AddColumn(parseColumnDefinition(words))
+	case words.match("DROP", "CONSTRAINT"), words.match("DROP", "FOREIGN", "KEY"):
+		words.match("IF", "EXISTS")
+		alter.DropConstraint(words.next())
+	case words.match("DROP", "PRIMARY", "KEY"):
+		alter.DropConstraint("PRIMARY KEY")
+	case words.match("DROP", "CHECK"):
+		alter.DropConstraint(words.next())
+	case words.matchAny("DROP", "INDEX", "KEY"):
+		// MySQL drops indexes without blocking writes
+	case words.match("DROP"):
+		words.match("COLUMN")
+		words.match("IF", "EXISTS")
+		alter.DropColumn(NewColumn(words.next()))
+	case words.matchAny("RENAME", "TO", "AS", "CONSTRAINT", "INDEX", "KEY"):
+		// Table, constraint and index renames are not checked
+	case words.match("RENAME", "COLUMN"), words.match("RENAME"):
+		name := words.next()
+		if words.match("TO") {
+			alter.AlterColumn(NewColumn(name).SetNewName(words.next()))
+		}
+	case words.match("ALTER"):
+		words.match("COLUMN")
+		column := NewColumn(words.next())
+		switch {
+		case words.match("TYPE"), words.match("SET", "DATA", "TYPE"):
+			alter.AlterColumn(column.SetNewDataType(words.dataType()))
+		case words.match("SET", "NOT", "NULL"):
+			alter.SetColumnNotNull(column)
+		}
+	case words.match("MODIFY"):
+		words.match("COLUMN")
+		column := parseColumnDefinition(words)
+		alter.AlterColumn(column.SetNewDataType(column.DataType))
+	case words.match("CHANGE"):
+		words.match("COLUMN")
+		name := words.next()
+		column := parseColumnDefinition(words)
+		if column.Name != name {
+			alter.AlterColumn(NewColumn(name).SetNewName(column.Name))
+		}
+		alter.AlterColumn(NewColumn(column.Name).SetNewDataType(column.DataType))
+	case words.match("DETACH", "PARTITION"):
+		partition := NewPartition(words.next(), alter.TableName)
+		if words.match("CONCURRENTLY") {
+			partition.SetConcurrently()
+		}
+		alter.DetachPartition(partition)
+	}
+}
+
+// parseColumnDefinition reads "name type constraints..." into a column carrying the flags
+// checked by the Linter
+func parseColumnDefinition(words sqlWords) *Column {
+	column := NewColumn(words.next())
+	dataType := words.dataType()
+	column.SetDataType(dataType)
+
+	constraints := " " + strings.ToUpper(words.rest()) + " "
+	column.NotNull = strings.Contains(constraints, " NOT NULL ")
+	column.PrimaryKey = strings.Contains(constraints, " PRIMARY KEY ")
+	column.Unique = strings.Contains(constraints, " UNIQUE ")
+	if strings.Contains(constraints, " GENERATED ") || strings.Contains(constraints, " AS (") {
+		column.Generated = "generated"
+	}
+	column.AutoNumber = strings.Contains(constraints, " AUTO_INCREMENT ") ||
+		slices.Contains([]string{"SERIAL", "BIGSERIAL", "SMALLSERIAL"}, strings.ToUpper(string(dataType)))
+	if _, check, ok := strings.Cut(constraints, " CHECK "); ok {
+		column.Check = strings.TrimSpace(check)
+	}
+	if _, references, ok := strings.Cut(constraints, " REFERENCES "); ok {
+		column.References = strings.TrimSpace(references)
+	}
+	for len(words) > 0 {
+		if words.match("DEFAULT") {
+			column.Default = parseCatalogDefault(words.expression())
+			break
+		}
+		words.next()
+	}
+	return column
+}
+
+// columnKeywords end the expression of a DEFAULT clause
+var columnKeywords = []string{"NOT", "NULL", "PRIMARY", "UNIQUE", "CHECK", "REFERENCES", "CONSTRAINT",
+	"GENERATED", "COLLATE", "AUTO_INCREMENT", "COMMENT", "ON", "FIRST", "AFTER"}
+
+// expression consumes tokens up to the next column constraint keyword, joining function calls
+// to their arguments, e.g. gen_random_uuid()
+func (w *sqlWords) expression() string {
+	var expression strings.Builder
+	for len(*w) > 0 && !slices.ContainsFunc(columnKeywords, func(k string) bool { return strings.EqualFold((*w)[0], k) }) {
+		token := w.next()
+		if expression.Len() > 0 && !strings.HasPrefix(token, "(") {
+			expression.WriteByte(' ')
+		}
+		expression.WriteString(token)
+	}
+	return expression.String()
+}
+
+// addConstraint adds a constraint definition, honoring a trailing NOT VALID
+func addConstraint(alter *AlterTable, name string, words sqlWords) {
+	if len(words) >= 2 && strings.EqualFold(words[len(words)-2], "NOT") && strings.EqualFold(words[len(words)-1], "VALID") {
+		alter.AddConstraintNotValid(name, words[:len(words)-2].rest())
+		return
+	}
+	alter.AddConstraint(name, words.rest())
+}
+
+// sqlWords is a cursor over the tokens of a statement
+type sqlWords []string
+
+// match consumes the keywords if the statement continues with them
+func (w *sqlWords) match(keywords ...string) bool {
+	if len(*w) < len(keywords) {
+		return false
+	}
+	for i, keyword := range keywords {
+		if !strings.EqualFold((*w)[i], keyword) {
+			return false
+		}
+	}
+	*w = (*w)[len(keywords):]
+	return true
+}
+
+// matchAny reports, without consuming, whether the statement continues with first followed
+// by one of the alternatives (or with first alone when there are none)
+func (w sqlWords) matchAny(first string, alternatives ...string) bool {
+	if len(w) == 0 || !strings.EqualFold(w[0], first) {
+		return false
+	}
+	if len(alternatives) == 0 {
+		return true
+	}
+	return len(w) > 1 && slices.ContainsFunc(alternatives, func(a string) bool { return strings.EqualFold(w[1], a) })
+}
+
+// next consumes one token
+func (w *sqlWords) next() string {
+	if len(*w) == 0 {
+		return ""
+	}
+	token := (*w)[0]
+	*w = (*w)[1:]
+	return token
+}
+
+// dataType consumes a type name and its parenthesized modifiers, e.g. numeric(12, 2)
+func (w *sqlWords) dataType() DataType {
+	dataType := w.next()
+	if len(*w) > 0 && strings.HasPrefix((*w)[0], "(") {
+		dataType += w.next()
+	}
+	return DataType(dataType)
+}
+
+// list consumes a comma separated list of names
+func (w *sqlWords) list() []string {
+	var names []string
+	for len(*w) > 0 && (*w)[0] != "," {
+		names = append(names, w.next())
+		if !w.match(",") {
+			break
+		}
+	}
+	return names
+}
+
+// split splits the remaining tokens on commas
+func (w sqlWords) split() []sqlWords {
+	var clauses []sqlWords
+	start := 0
+	for i, token := range w {
+		if token == "," {
+			clauses = append(clauses, w[start:i])
+			start = i + 1
+		}
+	}
+	return append(clauses, w[start:])
+}
+
+// rest joins the remaining tokens
+func (w sqlWords) rest() string {
+	return strings.Join(w, " ")
+}
@@ -0,0 +1,281 @@
+package gomb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PartitionStrategy is the partitioning method of a partitioned table
+type PartitionStrategy string
+
+const (
+	RangePartition PartitionStrategy = "RANGE"
+	ListPartition  PartitionStrategy = "LIST"
+	HashPartition  PartitionStrategy = "HASH"
+)
+
+// PartitionBy is the PARTITION BY clause of a partitioned table
+type PartitionBy struct {
+	Strategy PartitionStrategy `json:"strategy"`
+	Columns  []string          `json:"columns"` // Column names or expressions
+}
+
+// ToSQL generates the PARTITION BY clause
+func (pb *PartitionBy) ToSQL() (string, error) {
+	switch pb.Strategy {
+	case RangePartition, ListPartition, HashPartition:
+	default:
+		return "", ErrInvalidPartition.at("", "", "partition_by", fmt.Sprintf("invalid partition strategy: %s", pb.Strategy))
+	}
+	if len(pb.Columns) == 0 {
+		return "", ErrRequired.at("", "", "partition_by", "at least one partition column is required")
+	}
+	if pb.Strategy == ListPartition && len(pb.Columns) > 1 {
+		return "", ErrInvalidPartition.at("", "", "partition_by", "list partitioning takes a single column")
+	}
+	return fmt.Sprintf("PARTITION BY %s (%s)", pb.Strategy, strings.Join(pb.Columns, ", ")), nil
+}
+
+// Partition represents a partition of a partitioned table (PostgreSQL), created with
+// CREATE TABLE ... PARTITION OF or attached and detached through AlterTable
+type Partition struct {
+	name         string
+	parent       string
+	schema       string
+	from, to     []any
+	in           []any
+	modulus      int
+	remainder    int
+	isDefault    bool
+	partitionBy  *PartitionBy
+	concurrently bool
+}
+
+// NewPartition creates a partition named name of the parent table
+func NewPartition(name, parent string) *Partition {
+	return &Partition{name: name, parent: parent}
+}
+
+// SetSchema sets the schema of the partition and its parent
+func (p *Partition) SetSchema(schema string) *Partition {
+	p.schema = schema
+	return p
+}
+
+// ForRange sets the bounds FOR VALUES FROM (from) TO (to); pass a []any for multi-column
+// bounds and Raw("MINVALUE") / Raw("MAXVALUE") for unbounded ends
+func (p *Partition) ForRange(from, to any) *Partition {
+	p.from, p.to = boundValues(from), boundValues(to)
+	return p
+}
+
+// ForValues sets the bounds FOR VALUES IN (values...) of a list partition
+func (p *Partition) ForValues(values ...any) *Partition {
+	p.in = values
+	return p
+}
+
+// ForHash sets the bounds FOR VALUES WITH (MODULUS m, REMAINDER r) of a hash partition
+func (p *Partition) ForHash(modulus, remainder int) *Partition {
+	p.modulus, p.remainder = modulus, remainder
+	return p
+}
+
+// SetDefault makes this the DEFAULT partition, receiving rows no other partition accepts
+func (p *Partition) SetDefault() *Partition {
+	p.isDefault = true
+	return p
+}
+
+// SetPartitionBy partitions the partition itself (sub-partitioning)
+func (p *Partition) SetPartitionBy(strategy PartitionStrategy, columns ...string) *Partition {
+	p.partitionBy = &PartitionBy{Strategy: strategy, Columns: columns}
+	return p
+}
+
+// SetConcurrently detaches the partition concurrently (PostgreSQL 14+)
+func (p *Partition) SetConcurrently() *Partition {
+	p.concurrently = true
+	return p
+}
+
+// Name returns the name of the partition
+func (p *Partition) Name() string {
+	return p.name
+}
+
+// ToSQL generates the CREATE TABLE ... PARTITION OF statement
+func (p *Partition) ToSQL() (string, error) {
+	if p.name == "" {
+		return "", ErrRequired.at(p.parent, "", "name", "partition name is required")
+	}
+	if p.parent == "" {
+		return "", ErrRequired.at("", "", "parent", "parent table is required")
+	}
+
+	bound, err := p.boundSQL()
+	if err != nil {
+		return "", err
+	}
+
+	sql := fmt.Sprintf("CREATE TABLE %s PARTITION OF %s %s", qualify(p.schema, p.name), qualify(p.schema, p.parent), bound)
+	if p.partitionBy != nil {
+		partitionSQL, err := p.partitionBy.ToSQL()
+		if err != nil {
+			return "", inTable(err, p.name)
+		}
+		sql += " " + partitionSQL
+	}
+	return sql, nil
+}
+
+// boundSQL renders the partition bound: DEFAULT or FOR VALUES ...
+func (p *Partition) boundSQL() (string, error) {
+	kinds := 0
+	for _, set := range []bool{p.isDefault, p.from != nil || p.to != nil, p.in != nil, p.modulus != 0} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds == 0 {
+		return "", ErrRequired.at(p.name, "", "bounds", "partition bounds are required")
+	}
+	if kinds > 1 {
+		return "", ErrInvalidPartition.at(p.name, "", "bounds", "a partition takes exactly one of DEFAULT, a range, a list or a hash bound")
+	}
+
+	switch {
+	case p.isDefault:
+		return "DEFAULT", nil
+	case p.in != nil:
+		return fmt.Sprintf("FOR VALUES IN (%s)", literalList(p.in)), nil
+	case p.modulus != 0:
+		if p.modulus < 0 || p.remainder < 0 || p.remainder >= p.modulus {
+			return "", ErrInvalidPartition.at(p.name, "", "bounds", "hash remainder must be between 0 and modulus - 1")
+		}
+		return fmt.Sprintf("FOR VALUES WITH (MODULUS %d, REMAINDER %d)", p.modulus, p.remainder), nil
+	default:
+		if len(p.from) == 0 || len(p.to) == 0 || len(p.from) != len(p.to) {
+			return "", ErrInvalidPartition.at(p.name, "", "bounds", "range bounds need matching FROM and TO values")
+		}
+		return fmt.Sprintf("FOR VALUES FROM (%s) TO (%s)", literalList(p.from), literalList(p.to)), nil
+	}
+}
+
+// IsStatement implementation for SQL generation interface
+func (p *Partition) IsStatement() {}
+
+// Build renders the CREATE TABLE ... PARTITION OF statement for the given dialect
+func (p *Partition) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "table partitions"); err != nil {
+		return "", nil, err
+	}
+	sql, err := p.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the CREATE TABLE ... PARTITION OF statement for the target server
+func (p *Partition) BuildFor(target Target) (string, []any, error) {
+	if err := p.checkVersion(target); err != nil {
+		return "", nil, err
+	}
+	return p.Build(target.Dialect)
+}
+
+// checkVersion fails when the target server predates the partition's bounds
+func (p *Partition) checkVersion(target Target) error {
+	required := []feature{featurePartitioning}
+	if p.modulus != 0 || (p.partitionBy != nil && p.partitionBy.Strategy == HashPartition) {
+		required = append(required, featureHashPartitioning)
+	}
+	if p.isDefault {
+		required = append(required, featureDefaultPartition)
+	}
+	for _, f := range required {
+		if err := target.require(f, p.name, "", "bounds"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PartitionInterval is the width of each partition generated by TimeRangePartitions
+type PartitionInterval int
+
+const (
+	DailyPartitions PartitionInterval = iota + 1
+	WeeklyPartitions
+	MonthlyPartitions
+	YearlyPartitions
+)
+
+// TimeRangePartitions generates consecutive range partitions of the parent table covering
+// [start, end), aligned to the interval and named parent_YYYY, parent_YYYY_MM or
+// parent_YYYY_MM_DD, e.g. events_2024_01 FOR VALUES FROM ('2024-01-01') TO ('2024-02-01')
+func TimeRangePartitions(parent string, start, end time.Time, interval PartitionInterval) ([]*Partition, error) {
+	var nameLayout string
+	var step func(time.Time) time.Time
+	from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+
+	switch interval {
+	case DailyPartitions:
+		nameLayout, step = "2006_01_02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case WeeklyPartitions:
+		// Weeks start on Monday
+		from = from.AddDate(0, 0, -(int(from.Weekday())+6)%7)
+		nameLayout, step = "2006_01_02", func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case MonthlyPartitions:
+		from = time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location())
+		nameLayout, step = "2006_01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	case YearlyPartitions:
+		from = time.Date(from.Year(), 1, 1, 0, 0, 0, 0, from.Location())
+		nameLayout, step = "2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }
+	default:
+		return nil, ErrInvalidPartition.at(parent, "", "interval", fmt.Sprintf("invalid partition interval: %d", interval))
+	}
+	if !end.After(start) {
+		return nil, ErrInvalidPartition.at(parent, "", "bounds", "end must be after start")
+	}
+
+	var partitions []*Partition
+	for ; from.Before(end); from = step(from) {
+		to := step(from)
+		partitions = append(partitions, NewPartition(parent+"_"+from.Format(nameLayout), parent).
+			ForRange(from.Format("2006-01-02"), to.Format("2006-01-02")))
+	}
+	return partitions, nil
+}
+
+// boundValues normalises a range bound to a list of values
+func boundValues(value any) []any {
+	if values, ok := value.([]any); ok {
+		return values
+	}
+	return []any{value}
+}
+
+// literalList renders values as a comma-separated list of SQL literals; expressions such
+// as Raw("MINVALUE") are inlined
+func literalList(values []any) string {
+	literals := make([]string, len(values))
+	for i, value := range values {
+		if e, ok := value.(Expr); ok {
+			literals[i] = InlineExpr(e)
+		} else {
+			literals[i] = QuoteLiteral(value)
+		}
+	}
+	return strings.Join(literals, ", ")
+}
+
+// requirePostgres rejects features that only PostgreSQL supports
+func requirePostgres(dialect Dialect, feature string) error {
+	if err := validateDialect(dialect); err != nil {
+		return err
+	}
+	if dialect != PostgresDialect {
+		return ErrUnsupportedDialect.at("", "", "dialect", fmt.Sprintf("%s are not supported by %s", feature, dialect))
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package gomb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PlanStep is one statement of an online migration plan
+type PlanStep struct {
+	Description   string
+	Statement     Statement
+	Batched       bool // Repeated until it affects no rows
+	NoTransaction bool // Must run outside a transaction block, e.g. CREATE INDEX CONCURRENTLY
+}
+
+// Plan is a schema change rewritten into steps that avoid long locks
+type Plan struct {
+	Steps []PlanStep
+	// Offline lists the operations that still block the table, as ErrNotOnline errors; their
+	// steps are kept in the plan so that it can run in a maintenance window
+	Offline []error
+}
+
+// add appends a single transactional step
+func (plan *Plan) add(description string, statement Statement) {
+	plan.Steps = append(plan.Steps, PlanStep{Description: description, Statement: statement})
+}
+
+// Exec executes the steps in order, repeating batched steps until they affect no rows
+func (plan *Plan) Exec(ctx context.Context, db Execer) error {
+	for _, step := range plan.Steps {
+		for {
+			result, err := Exec(ctx, db, step.Statement)
+			if err != nil {
+				return err
+			}
+			if !step.Batched {
+				break
+			}
+			rows, err := result.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if rows == 0 {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// Planner rewrites ALTER TABLE operations and index builds that would lock a large table
+// into online steps (PostgreSQL)
+type Planner struct {
+	target    Target
+	batchSize int
+	keyColumn string
+}
+
+// NewPlanner creates a planner for the target server, backfilling 1000 rows at a time by id
+func NewPlanner(target Target) *Planner {
+	return &Planner{target: target, batchSize: 1000, keyColumn: "id"}
+}
+
+// SetBatchSize sets the number of rows updated by each backfill batch
+func (p *Planner) SetBatchSize(size int) *Planner {
+	p.batchSize = size
+	return p
+}
+
+// SetKeyColumn sets the unique column used to select backfill batches
+func (p *Planner) SetKeyColumn(column string) *Planner {
+	p.keyColumn = column
+	return p
+}
+
+// Plan rewrites the ALTER TABLE operations and the indexes into online steps:
+//   - columns with constraints are added nullable and the constraints are added NOT VALID and
+//     validated; a non-volatile default is kept on PostgreSQL 11+, which stores it without
+//     rewriting the table
+//   - a volatile default, or any default before PostgreSQL 11, is set after the column is
+//     added and existing rows are backfilled in batches. A NOT VALID CHECK (column IS NOT NULL)
+//     guards the backfill, so that a default evaluating to NULL fails instead of repeating the
+//     same batch forever; on a nullable column the guard is dropped afterwards, and rows written
+//     with an explicit NULL meanwhile are rejected
+//   - NOT NULL goes through a validated CHECK constraint
+//   - CHECK and FOREIGN KEY constraints are added NOT VALID, then validated
+//   - unique columns and indexes are built CONCURRENTLY
+//   - partitions are detached CONCURRENTLY
+//
+// Column type changes, primary keys, generated columns and attached partitions rewrite or scan
+// a table under an exclusive lock and are reported in Plan.Offline.
+func (p *Planner) Plan(alter *AlterTable, indexes ...*Index) (*Plan, error) {
+	if err := requirePostgres(p.target.Dialect, "online migration plans"); err != nil {
+		return nil, err
+	}
+	if p.batchSize <= 0 {
+		return nil, ErrInvalidOption.at("", "", "batch_size", "batch size must be positive")
+	}
+	if p.keyColumn == "" {
+		return nil, ErrRequired.at("", "", "key_column", "key column is required for backfills")
+	}
+
+	plan := &Plan{}
+	if alter != nil {
+		if errs := alter.Validate(); len(errs) > 0 {
+			return nil, errs[0]
+		}
+		for _, op := range alter.Operations {
+			p.planOperation(plan, alter.TableName, op)
+		}
+	}
+	for _, index := range indexes {
+		concurrent := *index
+		concurrent.concurrently = true
+		plan.Steps = append(plan.Steps, PlanStep{
+			Description:   fmt.Sprintf("build index %s without blocking writes", index.name),
+			Statement:     &concurrent,
+			NoTransaction: true,
+		})
+	}
+	return plan, nil
+}
+
+func (p *Planner) planOperation(plan *Plan, table string, op ColumnOperation) {
+	single := func(description string) {
+		alter := NewAlterTable(table)
+		alter.Operations = append(alter.Operations, op)
+		plan.add(description, alter)
+	}
+	offline := func(column, message string) {
+		plan.Offline = append(plan.Offline, ErrNotOnline.at(table, column, "operations", message))
+	}
+
+	switch op.Operation {
+	case AddColumnOp:
+		p.planAddColumn(plan, table, op.Column)
+	case SetNotNullOp:
+		p.planNotNull(plan, table, op.Column.Name)
+	case AddConstraintOp:
+		definition := strings.ToUpper(strings.TrimSpace(op.Constraint.Definition))
+		switch {
+		case op.Constraint.NotValid, strings.Contains(definition, "USING INDEX"):
+			single("add constraint " + op.Constraint.Name)
+		case strings.HasPrefix(definition, "CHECK"), strings.HasPrefix(definition, "FOREIGN KEY"):
+			p.planConstraint(plan, table, op.Constraint.Name, op.Constraint.Definition)
+		default:
+			offline("", fmt.Sprintf("constraint %s builds its index under an exclusive lock; build a unique index concurrently and add the constraint USING INDEX", op.Constraint.Name))
+			single("add constraint " + op.Constraint.Name)
+		}
+	case AlterColumnTypeOp:
+		offline(op.Column.Name, "changing the column type rewrites the table; add a new column, backfill it and switch over instead")
+		single("change the type of " + op.Column.Name)
+	case DetachPartitionOp:
+		if !op.Partition.concurrently {
+			if !p.target.supports(featureDetachConcurrently) {
+				offline("", fmt.Sprintf("detaching partition %s locks the parent table before PostgreSQL 14", op.Partition.name))
+				single("detach partition " + op.Partition.name)
+				return
+			}
+			partition := *op.Partition
+			partition.concurrently = true
+			op.Partition = &partition
+		}
+		alter := NewAlterTable(table)
+		alter.Operations = append(alter.Operations, op)
+		plan.Steps = append(plan.Steps, PlanStep{
+			Description:   "detach partition " + op.Partition.name + " without blocking queries",
+			Statement:     alter,
+			NoTransaction: true,
+		})
+	case AttachPartitionOp:
+		offline("", fmt.Sprintf("attaching partition %s scans it under an exclusive lock unless a validated CHECK constraint implies its bound; add one NOT VALID and validate it first", op.Partition.name))
+		single(operationName(op))
+	default:
+		// Dropping or renaming columns and the remaining operations only update the catalog
+		single(operationName(op))
+	}
+}
+
+func (p *Planner) planAddColumn(plan *Plan, table string, col *Column) {
+	if col.PrimaryKey || col.Generated != "" || col.AutoNumber {
+		plan.Offline = append(plan.Offline, ErrNotOnline.at(table, col.Name, "operations", "adding a primary key, generated or auto-number column rewrites the table"))
+		plan.add("add column "+col.Name, NewAlterTable(table).AddColumn(col))
+		return
+	}
+
+	hasDefault := col.Default != nil && !col.Default.IsNull()
+	if !hasDefault && !col.NotNull && col.Check == "" && col.References == "" && !col.Unique {
+		plan.add("add column "+col.Name, NewAlterTable(table).AddColumn(col))
+		return
+	}
+
+	// PostgreSQL 11+ stores a non-volatile default for the existing rows without a rewrite
+	backfill := hasDefault && (col.Default.volatile() || !p.target.supports(featureFastDefault))
+
+	base := *col
+	base.NotNull, base.Nullable = false, false
+	base.Check, base.References, base.Unique = "", "", false
+	if backfill {
+		base.Default = nil
+		plan.add("add column "+col.Name+" as nullable without a default or constraints", NewAlterTable(table).AddColumn(&base))
+	} else if hasDefault {
+		plan.add("add column "+col.Name+" as nullable with its default, without rewriting the table", NewAlterTable(table).AddColumn(&base))
+	} else {
+		plan.add("add column "+col.Name+" as nullable without constraints", NewAlterTable(table).AddColumn(&base))
+	}
+
+	name := constraintBaseName(table, col.Name)
+	check := name + "_not_null"
+	switch {
+	case backfill:
+		// The default is set before the guard, so that inserts omitting the column keep working
+		plan.add("set the default of "+col.Name+" for new rows", NewAlterTable(table).SetColumnDefault(col))
+		if col.NotNull {
+			plan.add("add constraint "+check+" without checking existing rows, so that the backfill fails if the default evaluates to NULL",
+				NewAlterTable(table).AddConstraintNotValid(check, fmt.Sprintf("CHECK (%s IS NOT NULL)", col.Name)))
+		}
+		batch := fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s IS NULL LIMIT %d)", p.keyColumn, p.keyColumn, table, col.Name, p.batchSize)
+		plan.Steps = append(plan.Steps, PlanStep{
+			Description: fmt.Sprintf("backfill %s in batches of %d rows", col.Name, p.batchSize),
+			Statement:   NewUpdate(table).Set(col.Name, Raw("DEFAULT")).Where(Raw(batch)),
+			Batched:     true,
+		})
+		if col.NotNull {
+			p.enforceNotNull(plan, table, col.Name, check)
+		}
+	case col.NotNull:
+		if !hasDefault {
+			plan.Offline = append(plan.Offline, ErrNotOnline.at(table, col.Name, "not_null", "a NOT NULL column without a default fails on a non-empty table; backfill it before validating"))
+		}
+		p.planNotNull(plan, table, col.Name)
+	}
+	if col.Check != "" {
+		p.planConstraint(plan, table, name+"_check", "CHECK "+col.Check)
+	}
+	if col.References != "" {
+		p.planConstraint(plan, table, name+"_fkey", fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s", col.Name, col.References))
+	}
+	if col.Unique {
+		index := NewIndex(name + "_key").OnTable(table).AddColumn(col.Name).SetUnique().SetConcurrently()
+		plan.Steps = append(plan.Steps, PlanStep{
+			Description:   "build the unique index of " + col.Name + " without blocking writes",
+			Statement:     index,
+			NoTransaction: true,
+		})
+		plan.add("add the unique constraint of "+col.Name+" using the index", NewAlterTable(table).AddConstraint(name+"_key", "UNIQUE USING INDEX "+name+"_key"))
+	}
+}
+
+// planNotNull sets NOT NULL through a validated CHECK constraint, which PostgreSQL 12+ uses
+// to skip the scan that SET NOT NULL would do under an exclusive lock
+func (p *Planner) planNotNull(plan *Plan, table, column string) {
+	check := constraintBaseName(table, column) + "_not_null"
+	plan.add("add constraint "+check+" without checking existing rows", NewAlterTable(table).AddConstraintNotValid(check, fmt.Sprintf("CHECK (%s IS NOT NULL)", column)))
+	p.enforceNotNull(plan, table, column, check)
+}
+
+// enforceNotNull validates the NOT VALID check of a column, sets NOT NULL and drops the check
+func (p *Planner) enforceNotNull(plan *Plan, table, column, check string) {
+	if !p.target.supports(featureNotNullFromCheck) {
+		plan.Offline = append(plan.Offline, ErrNotOnline.at(table, column, "not_null", "SET NOT NULL scans the table under an exclusive lock before PostgreSQL 12"))
+	}
+	plan.add("validate constraint "+check, NewAlterTable(table).ValidateConstraint(check))
+	plan.add("set "+column+" NOT NULL using the validated check", NewAlterTable(table).SetColumnNotNull(&Column{Name: column}))
+	plan.add("drop the redundant check "+check, NewAlterTable(table).DropConstraint(check))
+}
+
+// planConstraint adds a constraint NOT VALID, then validates it without blocking writes
+func (p *Planner) planConstraint(plan *Plan, table, name, definition string) {
+	plan.add("add constraint "+name+" without checking existing rows", NewAlterTable(table).AddConstraintNotValid(name, definition))
+	plan.add("validate constraint "+name, NewAlterTable(table).ValidateConstraint(name))
+}
+
+// constraintBaseName follows the PostgreSQL naming of column constraints, table_column,
+// without the schema of the table
+func constraintBaseName(table, column string) string {
+	if dot := strings.LastIndex(table, "."); dot >= 0 {
+		table = table[dot+1:]
+	}
+	return table + "_" + column
+}
+
+// operationName describes an operation passed through unchanged
+func operationName(op ColumnOperation) string {
+	switch {
+	case op.Column != nil:
+		return fmt.Sprintf("%s column %s", operationVerbs[op.Operation], op.Column.Name)
+	case op.Partition != nil:
+		return fmt.Sprintf("%s partition %s", operationVerbs[op.Operation], op.Partition.name)
+	case op.Constraint != nil:
+		return fmt.Sprintf("%s constraint %s", operationVerbs[op.Operation], op.Constraint.Name)
+	default:
+		return operationVerbs[op.Operation]
+	}
+}
+
+var operationVerbs = map[AlterTableOperation]string{
+	DropColumnOp:              "drop",
+	RenameColumnOp:            "rename",
+	SetDefaultOp:              "set the default of",
+	AttachPartitionOp:         "attach",
+	ValidateConstraintOp:      "validate",
+	DropConstraintOp:          "drop",
+	EnableRowLevelSecurityOp:  "enable row level security",
+	DisableRowLevelSecurityOp: "disable row level security",
+	ForceRowLevelSecurityOp:   "force row level security",
+	NoForceRowLevelSecurityOp: "stop forcing row level security",
+}
@@ -0,0 +1,336 @@
+package gomb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyCommand is the command a row level security policy applies to
+type PolicyCommand string
+
+const (
+	AllCommand    PolicyCommand = "ALL"
+	SelectCommand PolicyCommand = "SELECT"
+	InsertCommand PolicyCommand = "INSERT"
+	UpdateCommand PolicyCommand = "UPDATE"
+	DeleteCommand PolicyCommand = "DELETE"
+)
+
+// Policy represents a CREATE POLICY statement for row level security (PostgreSQL)
+type Policy struct {
+	name        string
+	table       string
+	schema      string
+	restrictive bool
+	command     PolicyCommand
+	roles       []string
+	using       string
+	withCheck   string
+}
+
+// NewPolicy creates a new row level security policy builder on the given table
+func NewPolicy(name, table string) *Policy {
+	return &Policy{name: name, table: table}
+}
+
+// SetSchema sets the schema of the table
+func (p *Policy) SetSchema(schema string) *Policy {
+	p.schema = schema
+	return p
+}
+
+// SetRestrictive makes the policy RESTRICTIVE, combined with AND instead of OR with other policies
+func (p *Policy) SetRestrictive() *Policy {
+	p.restrictive = true
+	return p
+}
+
+// SetCommand restricts the policy to a command, PostgreSQL defaults to ALL
+func (p *Policy) SetCommand(command PolicyCommand) *Policy {
+	p.command = command
+	return p
+}
+
+// To sets the roles the policy applies to, PostgreSQL defaults to PUBLIC
+func (p *Policy) To(roles ...string) *Policy {
+	p.roles = append(p.roles, roles...)
+	return p
+}
+
+// SetUsing sets the condition existing rows must satisfy, e.g. "tenant_id = current_setting('app.tenant_id')::int"
+func (p *Policy) SetUsing(condition string) *Policy {
+	p.using = condition
+	return p
+}
+
+// SetUsingExpr sets the USING condition from an expression, with values inlined as literals
+func (p *Policy) SetUsingExpr(condition Expr) *Policy {
+	p.using = InlineExpr(condition)
+	return p
+}
+
+// SetWithCheck sets the condition new and updated rows must satisfy
+func (p *Policy) SetWithCheck(condition string) *Policy {
+	p.withCheck = condition
+	return p
+}
+
+// SetWithCheckExpr sets the WITH CHECK condition from an expression, with values inlined as literals
+func (p *Policy) SetWithCheckExpr(condition Expr) *Policy {
+	p.withCheck = InlineExpr(condition)
+	return p
+}
+
+// ToSQL generates the CREATE POLICY statement
+func (p *Policy) ToSQL() (string, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	var sql strings.Builder
+	sql.WriteString(fmt.Sprintf("CREATE POLICY %s ON %s", p.name, qualify(p.schema, p.table)))
+	if p.restrictive {
+		sql.WriteString(" AS RESTRICTIVE")
+	}
+	if p.command != "" {
+		sql.WriteString(" FOR " + string(p.command))
+	}
+	writePolicyClauses(&sql, p.roles, p.using, p.withCheck)
+	return sql.String(), nil
+}
+
+func (p *Policy) validate() error {
+	switch p.command {
+	case "", AllCommand, SelectCommand, InsertCommand, UpdateCommand, DeleteCommand:
+	default:
+		return ErrInvalidOption.at(p.table, "", "command", fmt.Sprintf("invalid policy command: %s", p.command))
+	}
+
+	switch {
+	case p.name == "":
+		return ErrRequired.at(p.table, "", "name", "policy name is required")
+	case p.table == "":
+		return ErrRequired.at("", "", "table", "table name is required")
+	case p.command == InsertCommand && p.using != "":
+		return ErrInvalidOption.at(p.table, "", "using", "INSERT policies take WITH CHECK only")
+	case (p.command == SelectCommand || p.command == DeleteCommand) && p.withCheck != "":
+		return ErrInvalidOption.at(p.table, "", "with_check", fmt.Sprintf("%s policies take USING only", p.command))
+	}
+	return nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (p *Policy) IsStatement() {}
+
+// Build renders the CREATE POLICY statement for the given dialect
+func (p *Policy) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "row level security policies"); err != nil {
+		return "", nil, err
+	}
+	sql, err := p.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the CREATE POLICY statement for the target server
+func (p *Policy) BuildFor(target Target) (string, []any, error) {
+	if p.restrictive {
+		if err := target.require(featureRestrictivePolicies, p.table, "", "restrictive"); err != nil {
+			return "", nil, err
+		}
+	}
+	return p.Build(target.Dialect)
+}
+
+// AlterPolicy represents an ALTER POLICY statement
+type AlterPolicy struct {
+	name      string
+	table     string
+	schema    string
+	rename    string
+	roles     []string
+	using     string
+	withCheck string
+}
+
+// NewAlterPolicy creates a new alter policy builder
+func NewAlterPolicy(name, table string) *AlterPolicy {
+	return &AlterPolicy{name: name, table: table}
+}
+
+// SetSchema sets the schema of the table
+func (ap *AlterPolicy) SetSchema(schema string) *AlterPolicy {
+	ap.schema = schema
+	return ap
+}
+
+// RenameTo renames the policy; it cannot be combined with other changes
+func (ap *AlterPolicy) RenameTo(name string) *AlterPolicy {
+	ap.rename = name
+	return ap
+}
+
+// To replaces the roles the policy applies to
+func (ap *AlterPolicy) To(roles ...string) *AlterPolicy {
+	ap.roles = append(ap.roles, roles...)
+	return ap
+}
+
+// SetUsing replaces the USING condition
+func (ap *AlterPolicy) SetUsing(condition string) *AlterPolicy {
+	ap.using = condition
+	return ap
+}
+
+// SetUsingExpr replaces the USING condition with an expression, with values inlined as literals
+func (ap *AlterPolicy) SetUsingExpr(condition Expr) *AlterPolicy {
+	ap.using = InlineExpr(condition)
+	return ap
+}
+
+// SetWithCheck replaces the WITH CHECK condition
+func (ap *AlterPolicy) SetWithCheck(condition string) *AlterPolicy {
+	ap.withCheck = condition
+	return ap
+}
+
+// SetWithCheckExpr replaces the WITH CHECK condition with an expression, with values inlined as literals
+func (ap *AlterPolicy) SetWithCheckExpr(condition Expr) *AlterPolicy {
+	ap.withCheck = InlineExpr(condition)
+	return ap
+}
+
+// ToSQL generates the ALTER POLICY statement
+func (ap *AlterPolicy) ToSQL() (string, error) {
+	changes := len(ap.roles) > 0 || ap.using != "" || ap.withCheck != ""
+	switch {
+	case ap.name == "":
+		return "", ErrRequired.at(ap.table, "", "name", "policy name is required")
+	case ap.table == "":
+		return "", ErrRequired.at("", "", "table", "table name is required")
+	case ap.rename != "" && changes:
+		return "", ErrInvalidOption.at(ap.table, "", "rename", "a policy rename cannot be combined with other changes")
+	case ap.rename == "" && !changes:
+		return "", ErrRequired.at(ap.table, "", "operations", "alter policy must have at least one change")
+	}
+
+	var sql strings.Builder
+	sql.WriteString(fmt.Sprintf("ALTER POLICY %s ON %s", ap.name, qualify(ap.schema, ap.table)))
+	if ap.rename != "" {
+		sql.WriteString(" RENAME TO " + ap.rename)
+		return sql.String(), nil
+	}
+	writePolicyClauses(&sql, ap.roles, ap.using, ap.withCheck)
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (ap *AlterPolicy) IsStatement() {}
+
+// Build renders the ALTER POLICY statement for the given dialect
+func (ap *AlterPolicy) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "row level security policies"); err != nil {
+		return "", nil, err
+	}
+	sql, err := ap.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// DropPolicy represents a DROP POLICY statement
+type DropPolicy struct {
+	name     string
+	table    string
+	schema   string
+	ifExists bool
+	cascade  bool
+	restrict bool
+}
+
+// NewDropPolicy creates a new drop policy builder
+func NewDropPolicy(name, table string) *DropPolicy {
+	return &DropPolicy{name: name, table: table}
+}
+
+// SetSchema sets the schema of the table
+func (dp *DropPolicy) SetSchema(schema string) *DropPolicy {
+	dp.schema = schema
+	return dp
+}
+
+// SetIfExists adds IF EXISTS to the drop statement
+func (dp *DropPolicy) SetIfExists() *DropPolicy {
+	dp.ifExists = true
+	return dp
+}
+
+// SetCascade adds CASCADE to the drop statement
+func (dp *DropPolicy) SetCascade() *DropPolicy {
+	dp.cascade = true
+	dp.restrict = false
+	return dp
+}
+
+// SetRestrict adds RESTRICT to the drop statement
+func (dp *DropPolicy) SetRestrict() *DropPolicy {
+	dp.restrict = true
+	dp.cascade = false
+	return dp
+}
+
+// ToSQL generates the DROP POLICY statement
+func (dp *DropPolicy) ToSQL() (string, error) {
+	if dp.name == "" {
+		return "", ErrRequired.at(dp.table, "", "name", "policy name is required")
+	}
+	if dp.table == "" {
+		return "", ErrRequired.at("", "", "table", "table name is required")
+	}
+
+	var sql strings.Builder
+	sql.WriteString("DROP POLICY ")
+	if dp.ifExists {
+		sql.WriteString("IF EXISTS ")
+	}
+	sql.WriteString(dp.name + " ON " + qualify(dp.schema, dp.table))
+	if dp.cascade {
+		sql.WriteString(" CASCADE")
+	}
+	if dp.restrict {
+		sql.WriteString(" RESTRICT")
+	}
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (dp *DropPolicy) IsStatement() {}
+
+// Build renders the DROP POLICY statement for the given dialect
+func (dp *DropPolicy) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "row level security policies"); err != nil {
+		return "", nil, err
+	}
+	sql, err := dp.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// TablePolicy is a row level security policy declared on a table
+type TablePolicy struct {
+	Name        string        `json:"name"`
+	Command     PolicyCommand `json:"command,omitempty"`
+	Restrictive bool          `json:"restrictive,omitempty"`
+	Roles       []string      `json:"roles,omitempty"`
+	Using       string        `json:"using,omitempty"`
+	WithCheck   string        `json:"with_check,omitempty"`
+}
+
+// writePolicyClauses writes the TO, USING and WITH CHECK clauses shared by CREATE and ALTER POLICY
+func writePolicyClauses(sql *strings.Builder, roles []string, using, withCheck string) {
+	if len(roles) > 0 {
+		sql.WriteString(" TO " + strings.Join(roles, ", "))
+	}
+	if using != "" {
+		sql.WriteString(" USING (" + using + ")")
+	}
+	if withCheck != "" {
+		sql.WriteString(" WITH CHECK (" + withCheck + ")")
+	}
+}
@@ -0,0 +1,449 @@
+package gomb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Privilege is a privilege granted on a database object
+type Privilege string
+
+const (
+	SelectPrivilege     Privilege = "SELECT"
+	InsertPrivilege     Privilege = "INSERT"
+	UpdatePrivilege     Privilege = "UPDATE"
+	DeletePrivilege     Privilege = "DELETE"
+	TruncatePrivilege   Privilege = "TRUNCATE"
+	ReferencesPrivilege Privilege = "REFERENCES"
+	TriggerPrivilege    Privilege = "TRIGGER"
+	UsagePrivilege      Privilege = "USAGE"
+	CreatePrivilege     Privilege = "CREATE"
+	AllPrivileges       Privilege = "ALL PRIVILEGES"
+)
+
+// ObjectType is the kind of object privileges are granted on
+type ObjectType string
+
+const (
+	TableObject    ObjectType = "TABLE"
+	SequenceObject ObjectType = "SEQUENCE"
+	SchemaObject   ObjectType = "SCHEMA"
+)
+
+// objectPrivileges lists the privileges valid for each object type
+var objectPrivileges = map[ObjectType][]Privilege{
+	TableObject:    {SelectPrivilege, InsertPrivilege, UpdatePrivilege, DeletePrivilege, TruncatePrivilege, ReferencesPrivilege, TriggerPrivilege, AllPrivileges},
+	SequenceObject: {UsagePrivilege, SelectPrivilege, UpdatePrivilege, AllPrivileges},
+	SchemaObject:   {UsagePrivilege, CreatePrivilege, AllPrivileges},
+}
+
+// columnPrivileges lists the privileges that can be restricted to columns
+var columnPrivileges = []Privilege{SelectPrivilege, InsertPrivilege, UpdatePrivilege, ReferencesPrivilege, AllPrivileges}
+
+// privilegeTarget holds what GRANT and REVOKE have in common
+type privilegeTarget struct {
+	privileges []Privilege
+	columns    []string
+	objectType ObjectType
+	objects    []string
+	schema     string
+	roles      []string
+}
+
+// render renders "privileges ON type objects" after validating the combination
+func (pt *privilegeTarget) render() (string, error) {
+	switch {
+	case len(pt.privileges) == 0:
+		return "", ErrRequired.at("", "", "privileges", "at least one privilege is required")
+	case len(pt.objects) == 0:
+		return "", ErrRequired.at("", "", "objects", "at least one object is required")
+	case len(pt.roles) == 0:
+		return "", ErrRequired.at("", "", "roles", "at least one role is required")
+	}
+	for _, privilege := range pt.privileges {
+		if !slices.Contains(objectPrivileges[pt.objectType], privilege) {
+			return "", ErrInvalidOption.at("", "", "privileges", fmt.Sprintf("%s cannot be granted on a %s", privilege, strings.ToLower(string(pt.objectType))))
+		}
+		if len(pt.columns) > 0 && !slices.Contains(columnPrivileges, privilege) {
+			return "", ErrInvalidOption.at("", "", "columns", fmt.Sprintf("%s cannot be granted on columns", privilege))
+		}
+	}
+	if len(pt.columns) > 0 && pt.objectType != TableObject {
+		return "", ErrInvalidOption.at("", "", "columns", "column privileges apply to tables only")
+	}
+
+	privileges := make([]string, len(pt.privileges))
+	for i, privilege := range pt.privileges {
+		privileges[i] = string(privilege)
+		if len(pt.columns) > 0 {
+			privileges[i] += " (" + strings.Join(pt.columns, ", ") + ")"
+		}
+	}
+
+	objects := pt.objects
+	if pt.objectType != SchemaObject {
+		objects = strings.Split(qualifyAll(pt.schema, pt.objects), ", ")
+	}
+	return fmt.Sprintf("%s ON %s %s", strings.Join(privileges, ", "), pt.objectType, strings.Join(objects, ", ")), nil
+}
+
+// checkDialect rejects targets the dialect cannot express
+func (pt *privilegeTarget) checkDialect(dialect Dialect) error {
+	if err := validateDialect(dialect); err != nil {
+		return err
+	}
+	if dialect == SQLiteDialect {
+		return ErrUnsupportedDialect.at("", "", "dialect", "privileges are not supported by sqlite")
+	}
+	if dialect == MySQLDialect {
+		switch {
+		case pt.objectType != TableObject:
+			return ErrUnsupportedDialect.at("", "", "objects", fmt.Sprintf("%s privileges are not supported by mysql", strings.ToLower(string(pt.objectType))))
+		case len(pt.objects) > 1:
+			return ErrUnsupportedDialect.at("", "", "objects", "mysql grants privileges on one table per statement")
+		case slices.Contains(pt.privileges, TruncatePrivilege):
+			return ErrUnsupportedDialect.at("", "", "privileges", "mysql has no TRUNCATE privilege, it requires DROP")
+		}
+	}
+	return nil
+}
+
+// Grant represents a GRANT statement
+type Grant struct {
+	privilegeTarget
+	withGrantOption bool
+}
+
+// NewGrant creates a new grant builder for the privileges
+func NewGrant(privileges ...Privilege) *Grant {
+	return &Grant{privilegeTarget: privilegeTarget{privileges: privileges, objectType: TableObject}}
+}
+
+// OnTable grants the privileges on tables
+func (g *Grant) OnTable(tables ...string) *Grant {
+	g.objectType, g.objects = TableObject, tables
+	return g
+}
+
+// OnSequence grants the privileges on sequences (PostgreSQL)
+func (g *Grant) OnSequence(sequences ...string) *Grant {
+	g.objectType, g.objects = SequenceObject, sequences
+	return g
+}
+
+// OnSchema grants the privileges on schemas (PostgreSQL)
+func (g *Grant) OnSchema(schemas ...string) *Grant {
+	g.objectType, g.objects = SchemaObject, schemas
+	return g
+}
+
+// SetColumns restricts the privileges to columns of the table
+func (g *Grant) SetColumns(columns ...string) *Grant {
+	g.columns = columns
+	return g
+}
+
+// SetSchema sets the schema of the tables or sequences
+func (g *Grant) SetSchema(schema string) *Grant {
+	g.schema = schema
+	return g
+}
+
+// To sets the roles receiving the privileges; PUBLIC grants them to everyone
+func (g *Grant) To(roles ...string) *Grant {
+	g.roles = append(g.roles, roles...)
+	return g
+}
+
+// SetWithGrantOption lets the roles grant the privileges to others
+func (g *Grant) SetWithGrantOption() *Grant {
+	g.withGrantOption = true
+	return g
+}
+
+// ToSQL generates the GRANT statement
+func (g *Grant) ToSQL() (string, error) {
+	target, err := g.render()
+	if err != nil {
+		return "", err
+	}
+	sql := "GRANT " + target + " TO " + strings.Join(g.roles, ", ")
+	if g.withGrantOption {
+		sql += " WITH GRANT OPTION"
+	}
+	return sql, nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (g *Grant) IsStatement() {}
+
+// Build renders the GRANT statement for the given dialect
+func (g *Grant) Build(dialect Dialect) (string, []any, error) {
+	if err := g.checkDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	sql, err := g.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// Revoke represents a REVOKE statement
+type Revoke struct {
+	privilegeTarget
+	grantOptionFor bool
+	cascade        bool
+	restrict       bool
+}
+
+// NewRevoke creates a new revoke builder for the privileges
+func NewRevoke(privileges ...Privilege) *Revoke {
+	return &Revoke{privilegeTarget: privilegeTarget{privileges: privileges, objectType: TableObject}}
+}
+
+// OnTable revokes the privileges on tables
+func (r *Revoke) OnTable(tables ...string) *Revoke {
+	r.objectType, r.objects = TableObject, tables
+	return r
+}
+
+// OnSequence revokes the privileges on sequences (PostgreSQL)
+func (r *Revoke) OnSequence(sequences ...string) *Revoke {
+	r.objectType, r.objects = SequenceObject, sequences
+	return r
+}
+
+// OnSchema revokes the privileges on schemas (PostgreSQL)
+func (r *Revoke) OnSchema(schemas ...string) *Revoke {
+	r.objectType, r.objects = SchemaObject, schemas
+	return r
+}
+
+// SetColumns restricts the revoked privileges to columns of the table
+func (r *Revoke) SetColumns(columns ...string) *Revoke {
+	r.columns = columns
+	return r
+}
+
+// SetSchema sets the schema of the tables or sequences
+func (r *Revoke) SetSchema(schema string) *Revoke {
+	r.schema = schema
+	return r
+}
+
+// From sets the roles losing the privileges
+func (r *Revoke) From(roles ...string) *Revoke {
+	r.roles = append(r.roles, roles...)
+	return r
+}
+
+// SetGrantOptionFor only revokes the right to grant the privileges (PostgreSQL)
+func (r *Revoke) SetGrantOptionFor() *Revoke {
+	r.grantOptionFor = true
+	return r
+}
+
+// SetCascade also revokes privileges granted onwards by the roles (PostgreSQL)
+func (r *Revoke) SetCascade() *Revoke {
+	r.cascade = true
+	r.restrict = false
+	return r
+}
+
+// SetRestrict fails if the privileges were granted onwards by the roles (PostgreSQL)
+func (r *Revoke) SetRestrict() *Revoke {
+	r.restrict = true
+	r.cascade = false
+	return r
+}
+
+// ToSQL generates the REVOKE statement
+func (r *Revoke) ToSQL() (string, error) {
+	target, err := r.render()
+	if err != nil {
+		return "", err
+	}
+
+	var sql strings.Builder
+	sql.WriteString("REVOKE ")
+	if r.grantOptionFor {
+		sql.WriteString("GRANT OPTION FOR ")
+	}
+	sql.WriteString(target + " FROM " + strings.Join(r.roles, ", "))
+	if r.cascade {
+		sql.WriteString(" CASCADE")
+	}
+	if r.restrict {
+		sql.WriteString(" RESTRICT")
+	}
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (r *Revoke) IsStatement() {}
+
+// Build renders the REVOKE statement for the given dialect
+func (r *Revoke) Build(dialect Dialect) (string, []any, error) {
+	if err := r.checkDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	if dialect == MySQLDialect && (r.grantOptionFor || r.cascade || r.restrict) {
+		return "", nil, ErrUnsupportedDialect.at("", "", "options", "GRANT OPTION FOR, CASCADE and RESTRICT are not supported by mysql")
+	}
+	sql, err := r.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// Role represents a CREATE ROLE statement
+type Role struct {
+	name       string
+	login      bool
+	password   string
+	createDB   bool
+	createRole bool
+	inRoles    []string
+}
+
+// NewRole creates a new role builder
+func NewRole(name string) *Role {
+	return &Role{name: name}
+}
+
+// SetLogin allows the role to log in (PostgreSQL)
+func (r *Role) SetLogin() *Role {
+	r.login = true
+	return r
+}
+
+// SetPassword sets the password of the role (PostgreSQL)
+func (r *Role) SetPassword(password string) *Role {
+	r.password = password
+	return r
+}
+
+// SetCreateDB allows the role to create databases (PostgreSQL)
+func (r *Role) SetCreateDB() *Role {
+	r.createDB = true
+	return r
+}
+
+// SetCreateRole allows the role to create roles (PostgreSQL)
+func (r *Role) SetCreateRole() *Role {
+	r.createRole = true
+	return r
+}
+
+// SetInRole makes the role a member of the given roles (PostgreSQL)
+func (r *Role) SetInRole(roles ...string) *Role {
+	r.inRoles = append(r.inRoles, roles...)
+	return r
+}
+
+// ToSQL generates the CREATE ROLE statement
+func (r *Role) ToSQL() (string, error) {
+	if r.name == "" {
+		return "", ErrRequired.at("", "", "name", "role name is required")
+	}
+
+	var options []string
+	if r.login {
+		options = append(options, "LOGIN")
+	}
+	if r.password != "" {
+		options = append(options, "PASSWORD "+QuoteString(r.password))
+	}
+	if r.createDB {
+		options = append(options, "CREATEDB")
+	}
+	if r.createRole {
+		options = append(options, "CREATEROLE")
+	}
+	if len(r.inRoles) > 0 {
+		options = append(options, "IN ROLE "+strings.Join(r.inRoles, ", "))
+	}
+
+	sql := "CREATE ROLE " + r.name
+	if len(options) > 0 {
+		sql += " WITH " + strings.Join(options, " ")
+	}
+	return sql, nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (r *Role) IsStatement() {}
+
+// Build renders the CREATE ROLE statement for the given dialect
+func (r *Role) Build(dialect Dialect) (string, []any, error) {
+	if dialect == SQLiteDialect {
+		return "", nil, ErrUnsupportedDialect.at("", "", "dialect", "roles are not supported by sqlite")
+	}
+	if dialect == MySQLDialect && (r.login || r.password != "" || r.createDB || r.createRole || len(r.inRoles) > 0) {
+		return "", nil, ErrUnsupportedDialect.at("", "", "options", "role options are not supported by mysql")
+	}
+	sql, err := r.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the CREATE ROLE statement for the target server
+func (r *Role) BuildFor(target Target) (string, []any, error) {
+	if err := target.require(featureMySQLRoles, "", "", "role"); err != nil {
+		return "", nil, err
+	}
+	return r.Build(target.Dialect)
+}
+
+// DropRole represents a DROP ROLE statement
+type DropRole struct {
+	names    []string
+	ifExists bool
+}
+
+// NewDropRole creates a new drop role builder
+func NewDropRole(names ...string) *DropRole {
+	return &DropRole{names: names}
+}
+
+// SetIfExists adds IF EXISTS to the drop statement
+func (dr *DropRole) SetIfExists() *DropRole {
+	dr.ifExists = true
+	return dr
+}
+
+// ToSQL generates the DROP ROLE statement
+func (dr *DropRole) ToSQL() (string, error) {
+	if len(dr.names) == 0 || slices.Contains(dr.names, "") {
+		return "", ErrRequired.at("", "", "name", "role name is required")
+	}
+	sql := "DROP ROLE "
+	if dr.ifExists {
+		sql += "IF EXISTS "
+	}
+	return sql + strings.Join(dr.names, ", "), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (dr *DropRole) IsStatement() {}
+
+// Build renders the DROP ROLE statement for the given dialect
+func (dr *DropRole) Build(dialect Dialect) (string, []any, error) {
+	if dialect == SQLiteDialect {
+		return "", nil, ErrUnsupportedDialect.at("", "", "dialect", "roles are not supported by sqlite")
+	}
+	sql, err := dr.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
+
+// BuildFor renders the DROP ROLE statement for the target server
+func (dr *DropRole) BuildFor(target Target) (string, []any, error) {
+	if err := target.require(featureMySQLRoles, "", "", "role"); err != nil {
+		return "", nil, err
+	}
+	return dr.Build(target.Dialect)
+}
+
+// TablePrivilege declares privileges a role holds on a table, granted by Table.Grants
+type TablePrivilege struct {
+	Role            string      `json:"role"`
+	Privileges      []Privilege `json:"privileges"`
+	Columns         []string    `json:"columns,omitempty"`
+	WithGrantOption bool        `json:"with_grant_option,omitempty"`
+}
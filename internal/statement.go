@@ -0,0 +1,26 @@
+package gomb
+
+import "errors"
+
+// Statement is implemented by every builder that renders a single SQL statement
+type Statement interface {
+	IsStatement()
+	// Build renders the statement for the given dialect, returning the SQL and its bound arguments
+	Build(dialect Dialect) (string, []any, error)
+}
+
+// buildSingle adapts a ToSQL result returning a single error to Build
+func buildSingle(dialect Dialect, sql string, err error) (string, []any, error) {
+	if err := validateDialect(dialect); err != nil {
+		return "", nil, err
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return sql, nil, nil
+}
+
+// buildMulti adapts a ToSQL result returning a list of errors to Build
+func buildMulti(dialect Dialect, sql string, errs []error) (string, []any, error) {
+	return buildSingle(dialect, sql, errors.Join(errs...))
+}
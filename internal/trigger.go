@@ -0,0 +1,285 @@
+package gomb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TriggerTiming is when a trigger fires relative to its event
+type TriggerTiming string
+
+const (
+	BeforeTrigger    TriggerTiming = "BEFORE"
+	AfterTrigger     TriggerTiming = "AFTER"
+	InsteadOfTrigger TriggerTiming = "INSTEAD OF"
+)
+
+// TriggerEvent is a statement that fires a trigger
+type TriggerEvent string
+
+const (
+	InsertEvent   TriggerEvent = "INSERT"
+	UpdateEvent   TriggerEvent = "UPDATE"
+	DeleteEvent   TriggerEvent = "DELETE"
+	TruncateEvent TriggerEvent = "TRUNCATE"
+)
+
+// Trigger represents a CREATE TRIGGER statement (PostgreSQL)
+type Trigger struct {
+	name          string
+	table         string
+	schema        string
+	orReplace     bool
+	timing        TriggerTiming
+	events        []TriggerEvent
+	updateColumns []string
+	forEach       string // ROW or STATEMENT, PostgreSQL defaults to STATEMENT
+	when          string
+	function      string
+	args          []string
+}
+
+// NewTrigger creates a new trigger builder on the given table
+func NewTrigger(name, table string) *Trigger {
+	return &Trigger{name: name, table: table}
+}
+
+// SetSchema sets the schema of the table
+func (tr *Trigger) SetSchema(schema string) *Trigger {
+	tr.schema = schema
+	return tr
+}
+
+// SetOrReplace adds OR REPLACE to the statement (PostgreSQL 14+)
+func (tr *Trigger) SetOrReplace() *Trigger {
+	tr.orReplace = true
+	return tr
+}
+
+// SetBefore fires the trigger before the events
+func (tr *Trigger) SetBefore(events ...TriggerEvent) *Trigger {
+	tr.timing = BeforeTrigger
+	tr.events = append(tr.events, events...)
+	return tr
+}
+
+// SetAfter fires the trigger after the events
+func (tr *Trigger) SetAfter(events ...TriggerEvent) *Trigger {
+	tr.timing = AfterTrigger
+	tr.events = append(tr.events, events...)
+	return tr
+}
+
+// SetInsteadOf fires the trigger instead of the events, on views
+func (tr *Trigger) SetInsteadOf(events ...TriggerEvent) *Trigger {
+	tr.timing = InsteadOfTrigger
+	tr.events = append(tr.events, events...)
+	return tr
+}
+
+// SetUpdateOf restricts the UPDATE event to changes of the given columns
+func (tr *Trigger) SetUpdateOf(columns ...string) *Trigger {
+	tr.updateColumns = append(tr.updateColumns, columns...)
+	return tr
+}
+
+// SetForEachRow fires the trigger once per affected row
+func (tr *Trigger) SetForEachRow() *Trigger {
+	tr.forEach = "ROW"
+	return tr
+}
+
+// SetForEachStatement fires the trigger once per statement
+func (tr *Trigger) SetForEachStatement() *Trigger {
+	tr.forEach = "STATEMENT"
+	return tr
+}
+
+// SetWhen sets the condition under which the trigger fires, e.g. "OLD.* IS DISTINCT FROM NEW.*"
+func (tr *Trigger) SetWhen(condition string) *Trigger {
+	tr.when = condition
+	return tr
+}
+
+// SetWhenExpr sets the condition from an expression, with values inlined as literals
+func (tr *Trigger) SetWhenExpr(condition Expr) *Trigger {
+	tr.when = InlineExpr(condition)
+	return tr
+}
+
+// SetFunction sets the trigger function executed by the trigger and its string arguments
+func (tr *Trigger) SetFunction(function string, args ...string) *Trigger {
+	tr.function = function
+	tr.args = args
+	return tr
+}
+
+// ToSQL generates the CREATE TRIGGER statement
+func (tr *Trigger) ToSQL() (string, error) {
+	return tr.render(Target{Dialect: PostgresDialect})
+}
+
+// render generates the CREATE TRIGGER statement for the target server, spelling EXECUTE
+// FUNCTION as EXECUTE PROCEDURE before PostgreSQL 11
+func (tr *Trigger) render(target Target) (string, error) {
+	if err := tr.validate(); err != nil {
+		return "", err
+	}
+
+	events := make([]string, len(tr.events))
+	for i, event := range tr.events {
+		events[i] = string(event)
+		if event == UpdateEvent && len(tr.updateColumns) > 0 {
+			events[i] += " OF " + strings.Join(tr.updateColumns, ", ")
+		}
+	}
+
+	var sql strings.Builder
+	sql.WriteString("CREATE ")
+	if tr.orReplace {
+		sql.WriteString("OR REPLACE ")
+	}
+	sql.WriteString("TRIGGER ")
+	sql.WriteString(tr.name)
+	sql.WriteString(" " + string(tr.timing) + " " + strings.Join(events, " OR "))
+	sql.WriteString(" ON " + qualify(tr.schema, tr.table))
+	if tr.forEach != "" {
+		sql.WriteString(" FOR EACH " + tr.forEach)
+	}
+	if tr.when != "" {
+		sql.WriteString(" WHEN (" + tr.when + ")")
+	}
+
+	args := make([]string, len(tr.args))
+	for i, arg := range tr.args {
+		args[i] = QuoteString(arg)
+	}
+	execute := "FUNCTION"
+	if !target.supports(featureExecuteFunction) {
+		execute = "PROCEDURE"
+	}
+	sql.WriteString(fmt.Sprintf(" EXECUTE %s %s(%s)", execute, tr.function, strings.Join(args, ", ")))
+	return sql.String(), nil
+}
+
+func (tr *Trigger) validate() error {
+	switch {
+	case tr.name == "":
+		return ErrRequired.at(tr.table, "", "name", "trigger name is required")
+	case tr.table == "":
+		return ErrRequired.at("", "", "table", "table name is required")
+	case tr.timing == "":
+		return ErrRequired.at(tr.table, "", "timing", "trigger timing is required")
+	case len(tr.events) == 0:
+		return ErrRequired.at(tr.table, "", "events", "at least one trigger event is required")
+	case tr.function == "":
+		return ErrRequired.at(tr.table, "", "function", "trigger function is required")
+	case len(tr.updateColumns) > 0 && !slices.Contains(tr.events, UpdateEvent):
+		return ErrInvalidOption.at(tr.table, "", "events", "UPDATE OF columns requires the UPDATE event")
+	case tr.timing == InsteadOfTrigger && (tr.forEach != "ROW" || tr.when != ""):
+		return ErrInvalidOption.at(tr.table, "", "timing", "INSTEAD OF triggers must be FOR EACH ROW without WHEN")
+	case slices.Contains(tr.events, TruncateEvent) && tr.forEach == "ROW":
+		return ErrInvalidOption.at(tr.table, "", "events", "TRUNCATE triggers must be FOR EACH STATEMENT")
+	}
+	return nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (tr *Trigger) IsStatement() {}
+
+// Build renders the CREATE TRIGGER statement for the given dialect
+func (tr *Trigger) Build(dialect Dialect) (string, []any, error) {
+	return tr.BuildFor(Target{Dialect: dialect})
+}
+
+// BuildFor renders the CREATE TRIGGER statement for the target server
+func (tr *Trigger) BuildFor(target Target) (string, []any, error) {
+	if err := requirePostgres(target.Dialect, "triggers"); err != nil {
+		return "", nil, err
+	}
+	if tr.orReplace {
+		if err := target.require(featureCreateOrReplaceTrigger, tr.table, "", "or_replace"); err != nil {
+			return "", nil, err
+		}
+	}
+	sql, err := tr.render(target)
+	return buildSingle(target.Dialect, sql, err)
+}
+
+// DropTrigger represents a DROP TRIGGER statement
+type DropTrigger struct {
+	name     string
+	table    string
+	schema   string
+	ifExists bool
+	cascade  bool
+	restrict bool
+}
+
+// NewDropTrigger creates a new drop trigger builder
+func NewDropTrigger(name, table string) *DropTrigger {
+	return &DropTrigger{name: name, table: table}
+}
+
+// SetSchema sets the schema of the table
+func (dt *DropTrigger) SetSchema(schema string) *DropTrigger {
+	dt.schema = schema
+	return dt
+}
+
+// SetIfExists adds IF EXISTS to the drop statement
+func (dt *DropTrigger) SetIfExists() *DropTrigger {
+	dt.ifExists = true
+	return dt
+}
+
+// SetCascade adds CASCADE to the drop statement
+func (dt *DropTrigger) SetCascade() *DropTrigger {
+	dt.cascade = true
+	dt.restrict = false
+	return dt
+}
+
+// SetRestrict adds RESTRICT to the drop statement
+func (dt *DropTrigger) SetRestrict() *DropTrigger {
+	dt.restrict = true
+	dt.cascade = false
+	return dt
+}
+
+// ToSQL generates the DROP TRIGGER statement
+func (dt *DropTrigger) ToSQL() (string, error) {
+	if dt.name == "" {
+		return "", ErrRequired.at(dt.table, "", "name", "trigger name is required")
+	}
+	if dt.table == "" {
+		return "", ErrRequired.at("", "", "table", "table name is required")
+	}
+
+	var sql strings.Builder
+	sql.WriteString("DROP TRIGGER ")
+	if dt.ifExists {
+		sql.WriteString("IF EXISTS ")
+	}
+	sql.WriteString(dt.name + " ON " + qualify(dt.schema, dt.table))
+	if dt.cascade {
+		sql.WriteString(" CASCADE")
+	}
+	if dt.restrict {
+		sql.WriteString(" RESTRICT")
+	}
+	return sql.String(), nil
+}
+
+// IsStatement implementation for SQL generation interface
+func (dt *DropTrigger) IsStatement() {}
+
+// Build renders the DROP TRIGGER statement for the given dialect
+func (dt *DropTrigger) Build(dialect Dialect) (string, []any, error) {
+	if err := requirePostgres(dialect, "triggers"); err != nil {
+		return "", nil, err
+	}
+	sql, err := dt.ToSQL()
+	return buildSingle(dialect, sql, err)
+}
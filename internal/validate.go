@@ -0,0 +1,211 @@
+package gomb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxIdentifierLengths are the identifier length limits of each dialect; SQLite has none
+var maxIdentifierLengths = map[Dialect]int{
+	PostgresDialect: 63,
+	MySQLDialect:    64,
+}
+
+// reservedWords are keywords that cannot be used as unquoted identifiers in any dialect
+var reservedWords = map[string]bool{
+	"all": true, "and": true, "as": true, "asc": true, "between": true, "both": true,
+	"case": true, "check": true, "collate": true, "column": true, "constraint": true,
+	"create": true, "cross": true, "default": true, "desc": true, "distinct": true,
+	"drop": true, "else": true, "exists": true, "false": true, "for": true, "foreign": true,
+	"from": true, "grant": true, "group": true, "having": true, "in": true, "inner": true,
+	"insert": true, "intersect": true, "into": true, "is": true, "join": true, "leading": true,
+	"left": true, "like": true, "limit": true, "not": true, "null": true, "on": true, "or": true,
+	"order": true, "outer": true, "primary": true, "references": true, "right": true,
+	"select": true, "table": true, "then": true, "to": true, "trailing": true, "true": true,
+	"union": true, "unique": true, "update": true, "using": true, "when": true, "where": true,
+	"with": true,
+}
+
+// dialectReservedWords are keywords reserved by a single dialect
+var dialectReservedWords = map[Dialect]map[string]bool{
+	PostgresDialect: {
+		"analyse": true, "analyze": true, "array": true, "current_user": true, "do": true,
+		"offset": true, "returning": true, "session_user": true, "user": true, "window": true,
+	},
+	MySQLDialect: {
+		"change": true, "condition": true, "div": true, "index": true, "interval": true,
+		"key": true, "keys": true, "match": true, "mod": true, "range": true, "rank": true,
+		"read": true, "usage": true,
+	},
+	SQLiteDialect: {
+		"autoincrement": true, "index": true, "transaction": true, "vacuum": true,
+	},
+}
+
+// Validator performs a semantic validation pass over a set of table definitions
+type Validator struct {
+	dialect             Dialect
+	version             Version
+	maxIdentifierLength int
+	tables              []*Table
+	structural          bool // Only structural checks: identifiers and references to other tables are not checked
+}
+
+// NewValidator creates a validator for the given dialect
+func NewValidator(dialect Dialect) *Validator {
+	return &Validator{dialect: dialect, maxIdentifierLength: maxIdentifierLengths[dialect]}
+}
+
+// AddTable adds tables to the schema set; foreign keys must point at tables in the set
+func (v *Validator) AddTable(tables ...*Table) *Validator {
+	v.tables = append(v.tables, tables...)
+	return v
+}
+
+// SetMaxIdentifierLength overrides the identifier length limit of the dialect, 0 disables the check
+func (v *Validator) SetMaxIdentifierLength(length int) *Validator {
+	v.maxIdentifierLength = length
+	return v
+}
+
+// SetVersion checks the schema against the given server version, the oldest one it must run on
+func (v *Validator) SetVersion(version Version) *Validator {
+	v.version = version
+	return v
+}
+
+// Validate returns every problem found in the schema set
+func (v *Validator) Validate() []error {
+	var errs []error
+	if err := validateDialect(v.dialect); err != nil {
+		return append(errs, err)
+	}
+
+	tableNames := map[string]*Table{}
+	for _, table := range v.tables {
+		if table.Name == "" {
+			errs = append(errs, ErrRequired.at("", "", "name", "table name cannot be empty"))
+			continue
+		}
+		if tableNames[table.Name] != nil {
+			errs = append(errs, ErrDuplicateName.at(table.Name, "", "name", "duplicate table name"))
+			continue
+		}
+		tableNames[table.Name] = table
+	}
+
+	for _, table := range v.tables {
+		if table.Name != "" {
+			errs = append(errs, v.validateTable(table, tableNames)...)
+		}
+	}
+	return errs
+}
+
+func (v *Validator) validateTable(table *Table, tableNames map[string]*Table) []error {
+	var errs []error
+	if err := v.validateIdentifier(table.Name); err != nil && !v.structural {
+		errs = append(errs, inTable(err, table.Name))
+	}
+	if len(table.Columns) == 0 && table.AsQuery == "" && table.Like == nil {
+		errs = append(errs, ErrRequired.at(table.Name, "", "columns", "table has no columns"))
+	}
+	if !v.structural {
+		target := Target{Dialect: v.dialect, Version: v.version}
+		if _, err := table.forTarget(target); err != nil {
+			errs = append(errs, err)
+		}
+		for _, index := range table.Indexes {
+			if err := index.checkVersion(target); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	var primaryKeys []string
+	for _, col := range table.Columns {
+		// Column problems are reported relative to the table
+		for _, err := range col.validate() {
+			errs = append(errs, inTable(err, table.Name))
+		}
+
+		problem := func(sentinel *ValidationError, field, message string) {
+			errs = append(errs, sentinel.at(table.Name, col.Name, field, message))
+		}
+
+		if col.Name == "" {
+			problem(ErrRequired, "name", "column name cannot be empty")
+			continue
+		}
+		if seen[col.Name] {
+			problem(ErrDuplicateName, "name", "duplicate column name")
+		}
+		seen[col.Name] = true
+
+		if err := v.validateIdentifier(col.Name); err != nil && !v.structural {
+			err.Table, err.Column = table.Name, col.Name
+			errs = append(errs, err)
+		}
+		if col.PrimaryKey {
+			primaryKeys = append(primaryKeys, col.Name)
+		}
+		if col.DataType == StringType && col.Length == 0 && v.dialect == MySQLDialect {
+			problem(ErrMissingLength, "length", fmt.Sprintf("VARCHAR requires a length on %s", v.dialect))
+		}
+		if col.Generated != "" && col.Default != nil {
+			problem(ErrGeneratedDefault, "default", "")
+		}
+		if col.References != "" && strings.Contains(col.References, "(") {
+			if msg := v.validateReference(col.References, tableNames); msg != "" {
+				problem(ErrUnknownReference, "references", msg)
+			}
+		}
+	}
+
+	if len(primaryKeys) > 1 {
+		message := fmt.Sprintf("multiple PRIMARY KEY columns: %s", strings.Join(primaryKeys, ", "))
+		errs = append(errs, ErrMultiplePrimaryKeys.at(table.Name, "", "columns", message))
+	}
+	return errs
+}
+
+// validateIdentifier returns an error located at the name field if the name is reserved or too long
+func (v *Validator) validateIdentifier(name string) *ValidationError {
+	lower := strings.ToLower(name)
+	if reservedWords[lower] || dialectReservedWords[v.dialect][lower] {
+		return ErrReservedIdentifier.at("", "", "name", fmt.Sprintf("%q is a reserved word on %s", name, v.dialect))
+	}
+	if v.maxIdentifierLength > 0 && len(name) > v.maxIdentifierLength {
+		return ErrIdentifierTooLong.at("", "", "name", fmt.Sprintf("identifier %q is longer than %d characters", name, v.maxIdentifierLength))
+	}
+	return nil
+}
+
+// validateReference checks that a "table(column, ...)" reference points at a known table and
+// columns
+func (v *Validator) validateReference(references string, tableNames map[string]*Table) string {
+	name, rest, _ := strings.Cut(references, "(")
+	columns, _, _ := strings.Cut(rest, ")")
+	name = strings.TrimSpace(name)
+
+	target := tableNames[name]
+	if target == nil {
+		// Schema-qualified references match tables declared without a schema
+		if _, unqualified, ok := strings.Cut(name, "."); ok {
+			target = tableNames[unqualified]
+		}
+	}
+	if target == nil {
+		if v.structural {
+			return ""
+		}
+		return fmt.Sprintf("foreign key references unknown table %s", name)
+	}
+	for _, column := range strings.Split(columns, ",") {
+		if column = strings.TrimSpace(column); target.column(column) == nil {
+			return fmt.Sprintf("foreign key references unknown column %s.%s", name, column)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package gomb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a database server version, e.g. 12, 8.0.16 or 3.35. The zero value stands for
+// the latest release, where every feature is available
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion parses a version such as "16", "8.0.36" or "15.4 (Debian 15.4-1)"; anything
+// after the numeric part, like a "-log" suffix, is ignored
+func ParseVersion(s string) (Version, error) {
+	s = strings.TrimSpace(s)
+	if end := strings.IndexFunc(s, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); end >= 0 {
+		s = s[:end]
+	}
+	parts := strings.Split(strings.TrimSuffix(s, "."), ".")
+	if s == "" || len(parts) > 3 {
+		return Version{}, ErrInvalidOption.at("", "", "version", fmt.Sprintf("invalid server version: %q", s))
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return Version{}, ErrInvalidOption.at("", "", "version", fmt.Sprintf("invalid server version: %q", s))
+		}
+		numbers[i] = n
+	}
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}, nil
+}
+
+// IsZero reports whether the version is unset
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Less reports whether v is an earlier release than other
+func (v Version) Less(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+func (v Version) String() string {
+	if v.Patch != 0 {
+		return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Target is the database server statements are rendered for
+type Target struct {
+	Dialect Dialect
+	Version Version // Zero for the latest release
+}
+
+// VersionedStatement is implemented by builders whose SQL depends on the server version
+type VersionedStatement interface {
+	Statement
+	// BuildFor renders the statement for the target server, failing with ErrUnsupportedVersion
+	// or leaving out optional clauses when the server predates a feature
+	BuildFor(target Target) (string, []any, error)
+}
+
+// BuildFor renders the statement for the target server; statements that do not depend on the
+// server version are built for its dialect
+func BuildFor(stmt Statement, target Target) (string, []any, error) {
+	if versioned, ok := stmt.(VersionedStatement); ok {
+		return versioned.BuildFor(target)
+	}
+	return stmt.Build(target.Dialect)
+}
+
+// feature is a SQL feature first available in a given release of a dialect
+type feature struct {
+	name    string
+	dialect Dialect
+	since   Version
+}
+
+var (
+	featureColumnCompression      = feature{"column compression", PostgresDialect, Version{Major: 14}}
+	featureGeneratedColumns       = feature{"generated columns", PostgresDialect, Version{Major: 12}}
+	featureSQLiteGenerated        = feature{"generated columns", SQLiteDialect, Version{Major: 3, Minor: 31}}
+	featureMySQLCheck             = feature{"CHECK constraints", MySQLDialect, Version{Major: 8, Minor: 0, Patch: 16}}
+	featurePartitioning           = feature{"declarative partitioning", PostgresDialect, Version{Major: 10}}
+	featureHashPartitioning       = feature{"hash partitioning", PostgresDialect, Version{Major: 11}}
+	featureDefaultPartition       = feature{"default partitions", PostgresDialect, Version{Major: 11}}
+	featureFastDefault            = feature{"adding a column with a non-volatile default without rewriting the table", PostgresDialect, Version{Major: 11}}
+	featureNotNullFromCheck       = feature{"SET NOT NULL using a validated CHECK constraint", PostgresDialect, Version{Major: 12}}
+	featureDetachConcurrently     = feature{"DETACH PARTITION CONCURRENTLY", PostgresDialect, Version{Major: 14}}
+	featureSQLiteDropColumn       = feature{"DROP COLUMN", SQLiteDialect, Version{Major: 3, Minor: 35}}
+	featureSQLiteRenameColumn     = feature{"RENAME COLUMN", SQLiteDialect, Version{Major: 3, Minor: 25}}
+	featureIndexInclude           = feature{"INCLUDE", PostgresDialect, Version{Major: 11}}
+	featureNullsNotDistinct       = feature{"NULLS NOT DISTINCT", PostgresDialect, Version{Major: 15}}
+	featureFunctionalKeyParts     = feature{"expression index keys", MySQLDialect, Version{Major: 8, Minor: 0, Patch: 13}}
+	featureReindexConcurrently    = feature{"REINDEX CONCURRENTLY", PostgresDialect, Version{Major: 12}}
+	featureReindexOptionList      = feature{"CONCURRENTLY and TABLESPACE in the REINDEX options list", PostgresDialect, Version{Major: 14}}
+	featureReindexWithoutName     = feature{"REINDEX DATABASE and SYSTEM without a name", PostgresDialect, Version{Major: 15}}
+	featureCreateOrReplaceTrigger = feature{"CREATE OR REPLACE TRIGGER", PostgresDialect, Version{Major: 14}}
+	featureExecuteFunction        = feature{"EXECUTE FUNCTION in triggers", PostgresDialect, Version{Major: 11}}
+	featureRestrictivePolicies    = feature{"restrictive policies", PostgresDialect, Version{Major: 10}}
+	featureAttachIndexPartition   = feature{"ALTER INDEX ATTACH PARTITION", PostgresDialect, Version{Major: 11}}
+	featureIndexColumnStatistics  = feature{"ALTER INDEX ALTER COLUMN SET STATISTICS", PostgresDialect, Version{Major: 11}}
+	featureNoDependsOnExtension   = feature{"NO DEPENDS ON EXTENSION", PostgresDialect, Version{Major: 13}}
+	featureProcedures             = feature{"procedures", PostgresDialect, Version{Major: 11}}
+	featureMySQLRoles             = feature{"roles", MySQLDialect, Version{Major: 8}}
+)
+
+// supports reports whether the target server has the feature; features of other dialects
+// are left to the dialect checks
+func (t Target) supports(f feature) bool {
+	return t.Dialect != f.dialect || t.Version.IsZero() || !t.Version.Less(f.since)
+}
+
+// postgres reports whether the target is PostgreSQL, which the zero Target stands for
+func (t Target) postgres() bool {
+	return t.Dialect == PostgresDialect || t.Dialect == ""
+}
+
+// require fails with ErrUnsupportedVersion, located at table, column and field, when the
+// target server predates the feature
+func (t Target) require(f feature, table, column, field string) error {
+	if t.supports(f) {
+		return nil
+	}
+	return ErrUnsupportedVersion.at(table, column, field, fmt.Sprintf("%s requires %s %s or later, target is %s", f.name, f.dialect, f.since, t.Version))
+}
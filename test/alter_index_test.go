@@ -0,0 +1,126 @@
+package gomb_test
+
+import (
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlterIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		alter   *gomb.AlterIndex
+		wantSQL string
+		wantErr error
+	}{
+		{
+			name:    "Rename",
+			alter:   gomb.NewAlterIndex("idx_users_email").SetSchema("auth").SetIfExists().RenameTo("idx_users_login"),
+			wantSQL: "ALTER INDEX IF EXISTS auth.idx_users_email RENAME TO idx_users_login",
+		},
+		{
+			name:    "Set Storage Parameters",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").SetStorageParameters("fillfactor=70", "deduplicate_items=off"),
+			wantSQL: "ALTER INDEX idx_orders_customer SET (fillfactor=70, deduplicate_items=off)",
+		},
+		{
+			name:    "Reset Storage Parameters",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").ResetStorageParameters("fillfactor"),
+			wantSQL: "ALTER INDEX idx_orders_customer RESET (fillfactor)",
+		},
+		{
+			name:    "Attach Partition",
+			alter:   gomb.NewAlterIndex("idx_events_created").AttachPartition("idx_events_2024_created"),
+			wantSQL: "ALTER INDEX idx_events_created ATTACH PARTITION idx_events_2024_created",
+		},
+		{
+			name:    "Set Statistics",
+			alter:   gomb.NewAlterIndex("idx_users_lower_email").SetStatistics(1, 1000),
+			wantSQL: "ALTER INDEX idx_users_lower_email ALTER COLUMN 1 SET STATISTICS 1000",
+		},
+		{
+			name:    "Depends On Extension",
+			alter:   gomb.NewAlterIndex("idx_docs_trgm").DependsOnExtension("pg_trgm"),
+			wantSQL: "ALTER INDEX idx_docs_trgm DEPENDS ON EXTENSION pg_trgm",
+		},
+		{
+			name:    "No Depends On Extension",
+			alter:   gomb.NewAlterIndex("idx_docs_trgm").NoDependsOnExtension("pg_trgm"),
+			wantSQL: "ALTER INDEX idx_docs_trgm NO DEPENDS ON EXTENSION pg_trgm",
+		},
+		{
+			name:    "All In Tablespace",
+			alter:   gomb.NewAlterIndexesInTablespace("slow_hdd").OwnedBy("app", "reporting").SetTablespace("fast_ssd").SetNowait(),
+			wantSQL: "ALTER INDEX ALL IN TABLESPACE slow_hdd OWNED BY app, reporting SET TABLESPACE fast_ssd NOWAIT",
+		},
+		{
+			name:    "All In Tablespace Other Action",
+			alter:   gomb.NewAlterIndexesInTablespace("slow_hdd").SetStorageParameters("fillfactor=70"),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Owned By Without All In Tablespace",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").OwnedBy("app").SetTablespace("fast_ssd"),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Set Tablespace Nowait",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").SetTablespace("fast_ssd").SetNowait(),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Attach Partition If Exists",
+			alter:   gomb.NewAlterIndex("idx_events_created").SetIfExists().AttachPartition("idx_events_2024_created"),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Depends On Extension If Exists",
+			alter:   gomb.NewAlterIndex("idx_docs_trgm").SetIfExists().NoDependsOnExtension("pg_trgm"),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Nowait Without Tablespace",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").RenameTo("idx_orders_buyer").SetNowait(),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Multiple Actions",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").RenameTo("idx_orders_buyer").SetTablespace("fast_ssd"),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Invalid Statistics Target",
+			alter:   gomb.NewAlterIndex("idx_users_lower_email").SetStatistics(0, 20000),
+			wantErr: gomb.ErrInvalidOption,
+		},
+		{
+			name:    "No Storage Parameters",
+			alter:   gomb.NewAlterIndex("idx_orders_customer").SetStorageParameters(),
+			wantErr: gomb.ErrRequired,
+		},
+		{
+			name:    "No Action",
+			alter:   gomb.NewAlterIndex("idx_orders_customer"),
+			wantErr: gomb.ErrRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.alter.Build(gomb.PostgresDialect)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+
+	t.Run("PostgreSQL Only", func(t *testing.T) {
+		_, _, err := gomb.NewAlterIndex("idx_orders_customer").RenameTo("idx_orders_buyer").Build(gomb.MySQLDialect)
+		assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+	})
+}
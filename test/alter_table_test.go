@@ -70,7 +70,7 @@ func TestAlterTable_ToSQL(t *testing.T) {
 				alter.AddColumn(gomb.NewColumn("status").SetDataType(gomb.StringType).SetLength(20))
 				return alter
 			}(),
-			wantSQL:    "ALTER TABLE orders ADD COLUMN status VARCHAR(20) COMMENT ON TABLE orders IS 'Updated orders table'",
+			wantSQL:    "ALTER TABLE orders ADD COLUMN status VARCHAR(20); COMMENT ON TABLE orders IS 'Updated orders table'",
 			wantErrors: false,
 		},
 		{
@@ -0,0 +1,88 @@
+package gomb_test
+
+import (
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGoStruct(t *testing.T) {
+	table := gomb.NewTable("user_accounts")
+	table.Comment = "stores user information"
+	table.AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey())
+	table.AddColumn(gomb.NewColumn("username").SetDataType(gomb.StringType).SetLength(50).SetNotNull().SetComment("Unique username"))
+	table.AddColumn(gomb.NewColumn("balance").SetDataType(gomb.DecimalType).SetPrecision(10).SetScale(2))
+	table.AddColumn(gomb.NewColumn("created_at").SetDataType(gomb.DateTimeType).SetNotNull())
+
+	t.Run("Pointer Nulls", func(t *testing.T) {
+		src, err := gomb.GenerateGoStruct(table, gomb.GoStructOptions{})
+		require.NoError(t, err)
+
+		expected := `// Code generated by gomb. DO NOT EDIT.
+
+package models
+
+import (
+	"time"
+)
+
+const (
+	UserAccountsTable           = "user_accounts"
+	UserAccountsColumnID        = "id"
+	UserAccountsColumnUsername  = "username"
+	UserAccountsColumnBalance   = "balance"
+	UserAccountsColumnCreatedAt = "created_at"
+)
+
+// UserAccounts stores user information
+type UserAccounts struct {
+	ID int64 ` + "`json:\"id\" db:\"id\"`" + `
+	// Unique username
+	Username  string    ` + "`json:\"username\" db:\"username\"`" + `
+	Balance   *float64  ` + "`json:\"balance\" db:\"balance\"`" + `
+	CreatedAt time.Time ` + "`json:\"created_at\" db:\"created_at\"`" + `
+}
+`
+		assert.Equal(t, expected, string(src))
+	})
+
+	t.Run("SQL Nulls And Custom Names", func(t *testing.T) {
+		src, err := gomb.GenerateGoStruct(table, gomb.GoStructOptions{Package: "store", TypeName: "Account", NullStyle: gomb.SQLNulls})
+		require.NoError(t, err)
+		assert.Contains(t, string(src), "package store\n")
+		assert.Contains(t, string(src), "\"database/sql\"")
+		assert.Contains(t, string(src), "Balance   sql.NullFloat64")
+		assert.Contains(t, string(src), "AccountColumnID ")
+	})
+
+	t.Run("Nullability Model", func(t *testing.T) {
+		table := gomb.NewTable("events").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetAutoNumber()).
+			AddColumn(gomb.NewColumn("deleted_at").SetDataType(gomb.DateTimeType).SetNullable()).
+			AddColumn(gomb.NewColumn("kind").SetDataType(gomb.StringType).SetNullability(gomb.NullabilityNotNull))
+		src, err := gomb.GenerateGoStruct(table, gomb.GoStructOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, string(src), "ID        int64 ")
+		assert.Contains(t, string(src), "DeletedAt *time.Time ")
+		assert.Contains(t, string(src), "Kind      string ")
+	})
+
+	t.Run("Reserved Column Names", func(t *testing.T) {
+		table := gomb.NewTable("order").
+			AddColumn(gomb.NewColumn("user").SetDataType(gomb.IntegerType).SetNotNull())
+		src, err := gomb.GenerateGoStruct(table, gomb.GoStructOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, string(src), "User int64 ")
+	})
+
+	t.Run("Invalid Table", func(t *testing.T) {
+		_, err := gomb.GenerateGoStruct(gomb.NewTable("empty"), gomb.GoStructOptions{})
+		assert.Error(t, err)
+
+		invalid := gomb.NewTable("bad").AddColumn(gomb.NewColumn("blob").SetDataType("bytea"))
+		_, err = gomb.GenerateGoStruct(invalid, gomb.GoStructOptions{})
+		assert.Error(t, err)
+	})
+}
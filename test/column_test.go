@@ -29,6 +29,20 @@ func TestMetadataBuilderToSQL(t *testing.T) {
 			expectedSQL: "id SERIAL PRIMARY KEY",
 			expectError: false,
 		},
+		{
+			name: "Test custom type column",
+			column: gomb.NewColumn("tags").
+				SetCustomType("text[]").
+				SetDefault("{}"),
+			expectedSQL: "tags text[] DEFAULT '{}'",
+			expectError: false,
+		},
+		{
+			name: "Test custom type column with invalid type name",
+			column: gomb.NewColumn("payload").
+				SetCustomType("jsonb; DROP TABLE users"),
+			expectError: true,
+		},
 		{
 			name: "Test String column with UNIQUE constraint",
 			column: gomb.NewColumn("username").
@@ -690,6 +704,14 @@ func TestColumn_ToSQL(t *testing.T) {
 			expectedSQL: "created_at TIMESTAMP GENERATED ALWAYS AS (CURRENT_TIMESTAMP)",
 			expectError: false,
 		},
+		{
+			name: "Test Decimal column with scale greater than precision",
+			column: gomb.NewColumn("ratio").
+				SetDataType(gomb.DecimalType).
+				SetPrecision(2).SetScale(4),
+			expectedSQL: "",
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -778,3 +800,83 @@ func TestColumn_ToSQL(t *testing.T) {
 		}
 	})
 }
+
+func TestColumnNullability(t *testing.T) {
+	tests := []struct {
+		name        string
+		column      *gomb.Column
+		expectedSQL string
+		expectError bool
+	}{
+		{
+			name:        "NOT NULL with timestamp default",
+			column:      gomb.NewColumn("created_at").SetDataType(gomb.DateTimeType).SetNotNull().SetDefault(gomb.DefaultCurrentTimestamp),
+			expectedSQL: "created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP",
+		},
+		{
+			name:        "NOT NULL with boolean default",
+			column:      gomb.NewColumn("is_active").SetDataType(gomb.BooleanType).SetNotNull().SetDefault(true),
+			expectedSQL: "is_active BOOLEAN NOT NULL DEFAULT TRUE",
+		},
+		{
+			name:        "Explicit NULL",
+			column:      gomb.NewColumn("deleted_at").SetDataType(gomb.DateTimeType).SetNullable(),
+			expectedSQL: "deleted_at TIMESTAMP NULL",
+		},
+		{
+			name:        "Unspecified",
+			column:      gomb.NewColumn("note").SetDataType(gomb.StringType).SetNullability(gomb.NullabilityUnspecified),
+			expectedSQL: "note VARCHAR",
+		},
+		{
+			name:        "NOT NULL with NULL default",
+			column:      gomb.NewColumn("note").SetDataType(gomb.StringType).SetNotNull().SetDefault(gomb.DefaultNull),
+			expectError: true,
+		},
+		{
+			name:        "NOT NULL with NULL literal default",
+			column:      gomb.NewColumn("note").SetDataType(gomb.StringType).SetNotNull().SetDefault(gomb.Literal(nil)),
+			expectError: true,
+		},
+		{
+			name:        "NULL and NOT NULL",
+			column:      &gomb.Column{Name: "note", DataType: gomb.StringType, NotNull: true, Nullable: true},
+			expectError: true,
+		},
+		{
+			name:        "NULL primary key",
+			column:      gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetPrimaryKey().SetNullable(),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, err := tt.column.ToSQL()
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Expected error: %v, got: %v", tt.expectError, err)
+			}
+			if sql != tt.expectedSQL {
+				t.Errorf("Generated SQL mismatch.\nExpected: %s\nGot: %s", tt.expectedSQL, sql)
+			}
+		})
+	}
+
+	if got := gomb.NewColumn("id").SetNullable().SetNotNull().Nullability(); got != gomb.NullabilityNotNull {
+		t.Errorf("Expected the last setter to win, got %q", got)
+	}
+
+	nullable := map[*gomb.Column]bool{
+		gomb.NewColumn("note").SetDataType(gomb.StringType):                 true,
+		gomb.NewColumn("note").SetDataType(gomb.StringType).SetNullable():   true,
+		gomb.NewColumn("note").SetDataType(gomb.StringType).SetNotNull():    false,
+		gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetPrimaryKey():  false,
+		gomb.NewColumn("id").SetDataType(gomb.SerialType):                   false,
+		gomb.NewColumn("seq").SetDataType(gomb.IntegerType).SetAutoNumber(): false,
+	}
+	for column, expected := range nullable {
+		if got := column.IsNullable(); got != expected {
+			t.Errorf("Expected IsNullable of %s (%q) to be %v", column.Name, column.Nullability(), expected)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package gomb_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	gomb "github.com/nandrechetan/gomb/internal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateTable_ToSQL(t *testing.T) {
@@ -52,7 +54,7 @@ func TestCreateTable_ToSQL(t *testing.T) {
 				table.AddColumn(gomb.NewColumn("name").SetDataType(gomb.StringType).SetLength(100))
 				return table
 			}(),
-			wantSQL:    "CREATE TABLE products (id SERIAL PRIMARY KEY, name VARCHAR(100)) COMMENT ON TABLE products IS 'Products table stores all product information'",
+			wantSQL:    "CREATE TABLE products (id SERIAL PRIMARY KEY, name VARCHAR(100)); COMMENT ON TABLE products IS 'Products table stores all product information'",
 			wantErrors: false,
 		},
 		{
@@ -122,7 +124,97 @@ func TestComplex_Scenarios(t *testing.T) {
 
 		sql, errors := table.ToSQL()
 		assert.Empty(t, errors, "Expected no errors but got: %v", errors)
-		expectedSQL := "CREATE TABLE users (id SERIAL PRIMARY KEY COMMENT 'User ID', username VARCHAR(50) NOT NULL COMMENT 'Unique username', email VARCHAR(255) NOT NULL COMMENT 'User email address', password_hash VARCHAR(100) NOT NULL, first_name VARCHAR(50), last_name VARCHAR(50), birth_date DATE, is_active BOOLEAN DEFAULT TRUE, login_count INTEGER DEFAULT 0, last_login TIMESTAMP, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP) COMMENT ON TABLE users IS 'Store user information'"
+		expectedSQL := "CREATE TABLE users (id SERIAL PRIMARY KEY COMMENT 'User ID', username VARCHAR(50) NOT NULL COMMENT 'Unique username', email VARCHAR(255) NOT NULL COMMENT 'User email address', password_hash VARCHAR(100) NOT NULL, first_name VARCHAR(50), last_name VARCHAR(50), birth_date DATE, is_active BOOLEAN DEFAULT TRUE, login_count INTEGER DEFAULT 0, last_login TIMESTAMP, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP); COMMENT ON TABLE users IS 'Store user information'"
 		assert.Equal(t, expectedSQL, sql)
 	})
 }
+
+func TestCreateTable_Options(t *testing.T) {
+	id := func() *gomb.Column { return gomb.NewColumn("id").SetDataType(gomb.IntegerType) }
+
+	tests := []struct {
+		name    string
+		table   *gomb.Table
+		dialect gomb.Dialect
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			name:    "If Not Exists Temporary",
+			table:   gomb.NewTable("scratch").AddColumn(id()).SetTemporary().SetIfNotExists(),
+			dialect: gomb.SQLiteDialect,
+			wantSQL: "CREATE TEMPORARY TABLE IF NOT EXISTS scratch (id INTEGER)",
+		},
+		{
+			name: "PostgreSQL Storage",
+			table: gomb.NewTable("events_archive").SetUnlogged().SetLike("events", "INCLUDING ALL").
+				AddWithOption("fillfactor=70").AddWithOption("autovacuum_enabled=false").SetTablespace("cold"),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "CREATE UNLOGGED TABLE events_archive (LIKE events INCLUDING ALL) WITH (fillfactor=70, autovacuum_enabled=false) TABLESPACE cold",
+		},
+		{
+			name:    "Inherits",
+			table:   gomb.NewTable("managers").AddColumn(id()).AddInherits("employees"),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "CREATE TABLE managers (id INTEGER) INHERITS (employees)",
+		},
+		{
+			name:    "MySQL Engine And Charset",
+			table:   gomb.NewTable("logs").AddColumn(id()).SetEngine("InnoDB").SetCharset("utf8mb4"),
+			dialect: gomb.MySQLDialect,
+			wantSQL: "CREATE TABLE logs (id INTEGER) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		},
+		{
+			name:    "Create Table As",
+			table:   gomb.NewTable("active_users").SetIfNotExists().SetAs("SELECT * FROM users WHERE active"),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "CREATE TABLE IF NOT EXISTS active_users AS SELECT * FROM users WHERE active",
+		},
+		{
+			name:    "Create Table As With Columns",
+			table:   gomb.NewTable("active_users").AddColumn(id()).SetAs("SELECT id FROM users"),
+			dialect: gomb.PostgresDialect,
+			wantErr: true,
+		},
+		{
+			name:    "Temporary And Unlogged",
+			table:   gomb.NewTable("scratch").AddColumn(id()).SetTemporary().SetUnlogged(),
+			dialect: gomb.PostgresDialect,
+			wantErr: true,
+		},
+		{
+			name:    "Engine On PostgreSQL",
+			table:   gomb.NewTable("logs").AddColumn(id()).SetEngine("InnoDB"),
+			dialect: gomb.PostgresDialect,
+			wantErr: true,
+		},
+		{
+			name:    "Inherits On MySQL",
+			table:   gomb.NewTable("managers").AddColumn(id()).AddInherits("employees"),
+			dialect: gomb.MySQLDialect,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.table.Build(tt.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+
+			// Options survive a JSON round trip
+			data, err := json.Marshal(tt.table)
+			require.NoError(t, err)
+			var decoded gomb.Table
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			decodedSQL, _, err := decoded.Build(tt.dialect)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, decodedSQL)
+			assert.Empty(t, decoded.Validate())
+		})
+	}
+}
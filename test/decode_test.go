@@ -0,0 +1,112 @@
+package gomb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeYAML(t *testing.T) {
+	t.Run("Table With Anchored Audit Columns", func(t *testing.T) {
+		doc := `
+audit:
+  - &created_at
+    name: created_at
+    data_type: datetime
+    default: CURRENT_TIMESTAMP
+  - name: updated_at
+    data_type: datetime
+
+table:
+  name: users
+  comment: Store user information
+  columns:
+    - name: id
+      data_type: serial
+      primary_key: true
+    - name: username
+      data_type: string
+      length: 50
+      not_null: true
+    - *created_at
+    - <<: *created_at
+      name: deleted_at
+      default: ""
+`
+		var schema struct {
+			Table *gomb.Table `json:"table"`
+		}
+		require.NoError(t, gomb.DecodeYAML([]byte(doc), &schema))
+
+		sql, errors := schema.Table.ToSQL()
+		assert.Empty(t, errors)
+		assert.Equal(t, "CREATE TABLE users (id SERIAL PRIMARY KEY, username VARCHAR(50) NOT NULL, created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, deleted_at TIMESTAMP); COMMENT ON TABLE users IS 'Store user information'", sql)
+	})
+
+	t.Run("Index Definition", func(t *testing.T) {
+		doc := `
+name: idx_users_email
+table: users
+columns: [email]
+unique: true
+where: deleted_at IS NULL
+`
+		var idx gomb.Index
+		require.NoError(t, gomb.DecodeYAML([]byte(doc), &idx))
+
+		sql, err := idx.ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE UNIQUE INDEX idx_users_email ON users (email) WHERE deleted_at IS NULL", sql)
+	})
+
+	t.Run("Invalid YAML", func(t *testing.T) {
+		var table gomb.Table
+		assert.Error(t, gomb.DecodeYAML([]byte("name: [users"), &table))
+	})
+}
+
+func TestDecodeTOML(t *testing.T) {
+	doc := `
+name = "products"
+
+[[columns]]
+name = "id"
+data_type = "serial"
+primary_key = true
+
+[[columns]]
+name = "price"
+data_type = "decimal"
+precision = 10
+scale = 2
+`
+	var table gomb.Table
+	require.NoError(t, gomb.DecodeTOML([]byte(doc), &table))
+
+	sql, errors := table.ToSQL()
+	assert.Empty(t, errors)
+	assert.Equal(t, "CREATE TABLE products (id SERIAL PRIMARY KEY, price DECIMAL(10,2))", sql)
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("Format From Extension", func(t *testing.T) {
+		path := filepath.Join(dir, "orders.yml")
+		require.NoError(t, os.WriteFile(path, []byte("name: orders\ncolumns:\n  - name: id\n    data_type: integer\n"), 0o644))
+
+		var table gomb.Table
+		require.NoError(t, gomb.LoadFile(path, &table))
+		assert.Equal(t, "orders", table.Name)
+		assert.Len(t, table.Columns, 1)
+	})
+
+	t.Run("Unsupported Extension", func(t *testing.T) {
+		var table gomb.Table
+		assert.Error(t, gomb.LoadFile(filepath.Join(dir, "orders.xml"), &table))
+	})
+}
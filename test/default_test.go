@@ -0,0 +1,133 @@
+package gomb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnDefault(t *testing.T) {
+	tests := []struct {
+		name   string
+		column *gomb.Column
+		want   string
+	}{
+		{
+			name:   "Numeric Looking String Stays Quoted",
+			column: gomb.NewColumn("code").SetDataType(gomb.StringType).SetLength(10).SetDefault(gomb.Literal("123")),
+			want:   "code VARCHAR(10) DEFAULT '123'",
+		},
+		{
+			name:   "Function Call Is Not Quoted",
+			column: gomb.NewColumn("id").SetDataType(gomb.StringType).SetLength(36).SetDefault(gomb.Func("gen_random_uuid")),
+			want:   "id VARCHAR(36) DEFAULT gen_random_uuid()",
+		},
+		{
+			name:   "Plain String Is Always A Literal",
+			column: gomb.NewColumn("note").SetDataType(gomb.StringType).SetLength(60).SetDefault("Note (draft)"),
+			want:   "note VARCHAR(60) DEFAULT 'Note (draft)'",
+		},
+		{
+			name:   "Keyword String Is Not Quoted",
+			column: gomb.NewColumn("created_at").SetDataType(gomb.DateTimeType).SetDefault("current_timestamp"),
+			want:   "created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP",
+		},
+		{
+			name:   "Function Lookalike Is Quoted",
+			column: gomb.NewColumn("note").SetDataType(gomb.StringType).SetLength(60).SetDefault("now()); DROP TABLE x; SELECT (1)"),
+			want:   "note VARCHAR(60) DEFAULT 'now()); DROP TABLE x; SELECT (1)'",
+		},
+		{
+			name:   "Decimal Literal",
+			column: gomb.NewColumn("rate").SetDataType(gomb.DecimalType).SetPrecision(6).SetScale(3).SetDefault("-1.5e2"),
+			want:   "rate DECIMAL(6,3) DEFAULT -1.5e2",
+		},
+		{
+			name:   "Local Time Keyword",
+			column: gomb.NewColumn("opened_at").SetDataType(gomb.DateTimeType).SetDefault(gomb.DefaultLocalTime),
+			want:   "opened_at TIMESTAMP DEFAULT LOCALTIME",
+		},
+		{
+			name:   "Function With Arguments",
+			column: gomb.NewColumn("seq").SetDataType(gomb.IntegerType).SetDefault(gomb.Func("nextval", "orders_seq")),
+			want:   "seq INTEGER DEFAULT nextval('orders_seq')",
+		},
+		{
+			name:   "Escaped String Literal",
+			column: gomb.NewColumn("note").SetDataType(gomb.StringType).SetLength(20).SetDefault("it's new"),
+			want:   "note VARCHAR(20) DEFAULT 'it''s new'",
+		},
+		{
+			name:   "Typed Integer",
+			column: gomb.NewColumn("qty").SetDataType(gomb.IntegerType).SetDefault(1),
+			want:   "qty INTEGER DEFAULT 1",
+		},
+		{
+			name:   "Date Literal",
+			column: gomb.NewColumn("starts_on").SetDataType(gomb.DateType).SetDefault("2024-01-31"),
+			want:   "starts_on DATE DEFAULT '2024-01-31'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, err := tt.column.ToSQL()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sql)
+		})
+	}
+
+	t.Run("Literal Must Match Data Type", func(t *testing.T) {
+		invalid := map[string]*gomb.Column{
+			"Integer": gomb.NewColumn("qty").SetDataType(gomb.IntegerType).SetDefault("abc"),
+			"Boolean": gomb.NewColumn("flag").SetDataType(gomb.BooleanType).SetDefault(gomb.Literal("maybe")),
+			"Date":    gomb.NewColumn("day").SetDataType(gomb.DateType).SetDefault("31/01/2024"),
+			"Decimal": gomb.NewColumn("price").SetDataType(gomb.DecimalType).SetPrecision(10).SetScale(2).SetDefault("ten"),
+			"NaN":     gomb.NewColumn("price").SetDataType(gomb.DecimalType).SetPrecision(10).SetScale(2).SetDefault("NaN"),
+			"Inf":     gomb.NewColumn("price").SetDataType(gomb.DecimalType).SetPrecision(10).SetScale(2).SetDefault("Inf"),
+		}
+		for name, column := range invalid {
+			assert.Error(t, column.Validate(), name)
+		}
+	})
+
+	t.Run("Function Name Is Validated", func(t *testing.T) {
+		for _, name := range []string{"f(1); DROP TABLE y; SELECT g", "now()", "", "1st"} {
+			column := gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetDefault(gomb.Func(name))
+			assert.Error(t, column.Validate(), name)
+		}
+		column := gomb.NewColumn("id").SetDataType(gomb.StringType).SetDefault(gomb.Func("public.gen_id"))
+		assert.NoError(t, column.Validate())
+	})
+}
+
+func TestDefaultExprJSON(t *testing.T) {
+	t.Run("Legacy String", func(t *testing.T) {
+		var column gomb.Column
+		require.NoError(t, json.Unmarshal([]byte(`{"name": "created_at", "data_type": "TIMESTAMP", "default": "current_timestamp"}`), &column))
+		assert.Equal(t, gomb.RawSQL("CURRENT_TIMESTAMP"), column.Default)
+	})
+
+	t.Run("Empty String Means No Default", func(t *testing.T) {
+		var column gomb.Column
+		require.NoError(t, json.Unmarshal([]byte(`{"name": "title", "data_type": "VARCHAR", "default": ""}`), &column))
+		assert.Nil(t, column.Default)
+	})
+
+	t.Run("Round Trip", func(t *testing.T) {
+		column := gomb.NewColumn("id").SetDataType(gomb.StringType).SetLength(36).SetDefault(gomb.Func("gen_random_uuid"))
+		data, err := json.Marshal(column)
+		require.NoError(t, err)
+
+		var decoded gomb.Column
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, gomb.FunctionDefault, decoded.Default.Kind)
+
+		sql, err := decoded.ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "id VARCHAR(36) DEFAULT gen_random_uuid()", sql)
+	})
+}
@@ -0,0 +1,107 @@
+package gomb_test
+
+import (
+	"database/sql"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsert(t *testing.T) {
+	t.Run("Multiple Rows With Returning", func(t *testing.T) {
+		insert := gomb.NewInsert("users").
+			Columns("username", "email", "active").
+			Values("john_doe", "john@example.com", true).
+			Values("jane", "jane@example.com", gomb.Raw("DEFAULT")).
+			Returning("id", "created_at")
+
+		sql, args, err := insert.Build(gomb.PostgresDialect)
+		require.NoError(t, err)
+		assert.Equal(t, "INSERT INTO users (username, email, active) VALUES ($1, $2, $3), ($4, $5, DEFAULT) RETURNING id, created_at", sql)
+		assert.Equal(t, []any{"john_doe", "john@example.com", true, "jane", "jane@example.com"}, args)
+
+		sql, err = insert.ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "INSERT INTO users (username, email, active) VALUES ('john_doe', 'john@example.com', TRUE), ('jane', 'jane@example.com', DEFAULT) RETURNING id, created_at", sql)
+	})
+
+	t.Run("MySQL", func(t *testing.T) {
+		sql, args, err := gomb.NewInsert("users").SetSchema("app").Columns("name").Values("ada").Build(gomb.MySQLDialect)
+		require.NoError(t, err)
+		assert.Equal(t, "INSERT INTO app.users (name) VALUES (?)", sql)
+		assert.Equal(t, []any{"ada"}, args)
+
+		_, _, err = gomb.NewInsert("users").Columns("name").Values("ada").Returning("id").Build(gomb.MySQLDialect)
+		assert.Error(t, err)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		testCases := map[string]*gomb.Insert{
+			"Missing table":   gomb.NewInsert("").Columns("a").Values(1),
+			"Missing columns": gomb.NewInsert("t").Values(1),
+			"Missing values":  gomb.NewInsert("t").Columns("a"),
+			"Value count":     gomb.NewInsert("t").Columns("a", "b").Values(1),
+		}
+		for name, insert := range testCases {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := insert.Build(gomb.PostgresDialect)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	update := gomb.NewUpdate("products").
+		Set("price", gomb.Raw("price * ?", 1.1)).
+		Set("updated_at", gomb.Raw("NOW()")).
+		Set("note", "it's on sale").
+		Where(gomb.Eq("category_id", 5)).
+		Where(gomb.Lt("price", 100))
+
+	t.Run("PostgreSQL", func(t *testing.T) {
+		sql, args, err := update.Build(gomb.PostgresDialect)
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATE products SET price = price * $1, updated_at = NOW(), note = $2 WHERE category_id = $3 AND price < $4", sql)
+		assert.Equal(t, []any{1.1, "it's on sale", 5, 100}, args)
+	})
+
+	t.Run("Named Placeholders", func(t *testing.T) {
+		query, args, err := update.SetPlaceholder(gomb.NamedPlaceholder).Build(gomb.SQLiteDialect)
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATE products SET price = price * :p1, updated_at = NOW(), note = :note WHERE category_id = :category_id AND price < :price", query)
+		assert.Equal(t, []any{sql.Named("p1", 1.1), sql.Named("note", "it's on sale"), sql.Named("category_id", 5), sql.Named("price", 100)}, args)
+	})
+
+	t.Run("Inlined", func(t *testing.T) {
+		sql, err := update.ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATE products SET price = price * 1.1, updated_at = NOW(), note = 'it''s on sale' WHERE category_id = 5 AND price < 100", sql)
+	})
+
+	t.Run("Qualified Named Parameters", func(t *testing.T) {
+		query, args, err := gomb.NewUpdate("users").Set("name", "ada").Where(gomb.Eq("u.id", 7)).Where(gomb.Eq("2fa", true)).
+			SetPlaceholder(gomb.NamedPlaceholder).Build(gomb.PostgresDialect)
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATE users SET name = :name WHERE u.id = :u_id AND 2fa = :p2fa", query)
+		assert.Equal(t, []any{sql.Named("name", "ada"), sql.Named("u_id", 7), sql.Named("p2fa", true)}, args)
+	})
+
+	t.Run("Missing Assignments", func(t *testing.T) {
+		_, err := gomb.NewUpdate("products").ToSQL()
+		assert.Error(t, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	sql, args, err := gomb.NewDelete("temp_records").
+		Where(gomb.Lt("created_at", gomb.Raw("NOW() - INTERVAL '30 days'"))).
+		SetPlaceholder(gomb.AtPlaceholder).
+		Where(gomb.Eq("kind", "log")).
+		Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "DELETE FROM temp_records WHERE created_at < NOW() - INTERVAL '30 days' AND kind = @p1", sql)
+	assert.Equal(t, []any{"log"}, args)
+}
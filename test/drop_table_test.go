@@ -18,3 +18,192 @@ func TestDropTable(t *testing.T) {
 		assert.Equal(t, expectedSQL, sql)
 	})
 }
+
+func TestDropTable_Options(t *testing.T) {
+	tests := []struct {
+		name    string
+		drop    *gomb.DropTable
+		dialect gomb.Dialect
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			name:    "Multiple Tables With Schema",
+			drop:    gomb.NewDropTable("orders", "order_items").SetSchema("sales").SetCascade(true),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "DROP TABLE IF EXISTS sales.orders, sales.order_items CASCADE",
+		},
+		{
+			name:    "Without If Exists",
+			drop:    gomb.NewDropTable("orders").AddTable("invoices").SetIfExists(false).SetRestrict(true),
+			dialect: gomb.MySQLDialect,
+			wantSQL: "DROP TABLE orders, invoices RESTRICT",
+		},
+		{
+			name:    "If Exists Turned Off",
+			drop:    gomb.NewDropTable("t").SetIfExists(false),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "DROP TABLE t",
+		},
+		{
+			name:    "Restrict Replaces Cascade",
+			drop:    gomb.NewDropTable("orders").SetCascade(true).SetRestrict(true),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "DROP TABLE IF EXISTS orders RESTRICT",
+		},
+		{
+			name:    "SQLite",
+			drop:    gomb.NewDropTable("orders"),
+			dialect: gomb.SQLiteDialect,
+			wantSQL: "DROP TABLE IF EXISTS orders",
+		},
+		{
+			name:    "SQLite Multiple Tables",
+			drop:    gomb.NewDropTable("orders", "invoices"),
+			dialect: gomb.SQLiteDialect,
+			wantErr: true,
+		},
+		{
+			name:    "SQLite Cascade",
+			drop:    gomb.NewDropTable("orders").SetCascade(true),
+			dialect: gomb.SQLiteDialect,
+			wantErr: true,
+		},
+		{
+			name:    "Empty Name",
+			drop:    gomb.NewDropTable("orders", ""),
+			dialect: gomb.PostgresDialect,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.drop.Build(tt.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		truncate *gomb.Truncate
+		dialect  gomb.Dialect
+		wantSQL  string
+		wantErr  bool
+	}{
+		{
+			name:     "PostgreSQL",
+			truncate: gomb.NewTruncate("orders", "order_items").SetSchema("sales").SetRestartIdentity().SetCascade(),
+			dialect:  gomb.PostgresDialect,
+			wantSQL:  "TRUNCATE TABLE sales.orders, sales.order_items RESTART IDENTITY CASCADE",
+		},
+		{
+			name:     "MySQL",
+			truncate: gomb.NewTruncate("orders"),
+			dialect:  gomb.MySQLDialect,
+			wantSQL:  "TRUNCATE TABLE orders",
+		},
+		{
+			name:     "SQLite",
+			truncate: gomb.NewTruncate("orders"),
+			dialect:  gomb.SQLiteDialect,
+			wantSQL:  "DELETE FROM orders",
+		},
+		{
+			name:     "MySQL Restart Identity",
+			truncate: gomb.NewTruncate("orders").SetRestartIdentity(),
+			dialect:  gomb.MySQLDialect,
+			wantErr:  true,
+		},
+		{
+			name:     "MySQL Multiple Tables",
+			truncate: gomb.NewTruncate("orders", "invoices"),
+			dialect:  gomb.MySQLDialect,
+			wantErr:  true,
+		},
+		{
+			name:     "No Tables",
+			truncate: gomb.NewTruncate(),
+			dialect:  gomb.PostgresDialect,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.truncate.Build(tt.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
+
+func TestDropSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		drop    *gomb.DropSchema
+		dialect gomb.Dialect
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			name:    "PostgreSQL",
+			drop:    gomb.NewDropSchema("staging", "archive").SetIfExists().SetCascade(),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "DROP SCHEMA IF EXISTS staging, archive CASCADE",
+		},
+		{
+			name:    "Restrict",
+			drop:    gomb.NewDropSchema("staging").SetCascade().SetRestrict(),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "DROP SCHEMA staging RESTRICT",
+		},
+		{
+			name:    "MySQL",
+			drop:    gomb.NewDropSchema("staging").SetIfExists(),
+			dialect: gomb.MySQLDialect,
+			wantSQL: "DROP SCHEMA IF EXISTS staging",
+		},
+		{
+			name:    "MySQL Cascade",
+			drop:    gomb.NewDropSchema("staging").SetCascade(),
+			dialect: gomb.MySQLDialect,
+			wantErr: true,
+		},
+		{
+			name:    "SQLite",
+			drop:    gomb.NewDropSchema("staging"),
+			dialect: gomb.SQLiteDialect,
+			wantErr: true,
+		},
+		{
+			name:    "No Schemas",
+			drop:    gomb.NewDropSchema(),
+			dialect: gomb.PostgresDialect,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.drop.Build(tt.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package gomb_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError(t *testing.T) {
+	t.Run("Column Validate", func(t *testing.T) {
+		err := gomb.NewColumn("price").SetDataType("MONEY").Validate()
+		require.ErrorIs(t, err, gomb.ErrInvalidDataType)
+
+		var validationErr *gomb.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "price", validationErr.Column)
+		assert.Equal(t, "data_type", validationErr.Field)
+		assert.Equal(t, "invalid_data_type", validationErr.Code)
+		assert.NotErrorIs(t, err, gomb.ErrInvalidScale)
+	})
+
+	t.Run("Table ToSQL", func(t *testing.T) {
+		table := gomb.NewTable("orders").
+			AddColumn(gomb.NewColumn("total").SetDataType(gomb.DecimalType).SetPrecision(4).SetScale(6))
+
+		_, errs := table.ToSQL()
+		require.NotEmpty(t, errs)
+		require.ErrorIs(t, errs[0], gomb.ErrInvalidScale)
+
+		var validationErr *gomb.ValidationError
+		require.ErrorAs(t, errs[0], &validationErr)
+		assert.Equal(t, "orders.total.scale", validationErr.Path())
+
+		_, errs = gomb.NewTable("").ToSQL()
+		assert.ErrorIs(t, errs[0], gomb.ErrRequired)
+	})
+
+	t.Run("Alter Table Validate", func(t *testing.T) {
+		errs := gomb.NewAlterTable("").Validate()
+		require.Len(t, errs, 2)
+		for _, err := range errs {
+			assert.ErrorIs(t, err, gomb.ErrRequired)
+		}
+
+		_, errs = gomb.NewAlterTable("users").AddColumn(gomb.NewColumn("flag").SetDataType("BIT")).ToSQL()
+		var validationErr *gomb.ValidationError
+		require.ErrorAs(t, errs[0], &validationErr)
+		assert.Equal(t, "users.flag.data_type", validationErr.Path())
+	})
+
+	t.Run("Index Builders", func(t *testing.T) {
+		_, err := gomb.NewIndex("idx_orders_total").OnTable("orders").ToSQL()
+		require.ErrorIs(t, err, gomb.ErrRequired)
+
+		var validationErr *gomb.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "orders.columns", validationErr.Path())
+
+		_, err = gomb.NewSetIndexTablespace("idx_orders_total", "").ToSQL()
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "tablespace", validationErr.Field)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		err := gomb.NewColumn("qty").SetDataType(gomb.IntegerType).SetNotNull().SetDefault(gomb.DefaultNull).Validate()
+		data, jsonErr := json.Marshal(err)
+		require.NoError(t, jsonErr)
+		assert.JSONEq(t, `{"column":"qty","field":"default","code":"not_null_default","message":"NOT NULL column cannot have a NULL default"}`, string(data))
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		err := errors.Join(errors.New("render"), gomb.NewColumn("id").Validate())
+		assert.ErrorIs(t, err, gomb.ErrInvalidDataType)
+	})
+}
@@ -0,0 +1,176 @@
+package gomb_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExec(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Executes Rendered Statements", func(t *testing.T) {
+		db, fake := openFakeDB(t)
+
+		table := gomb.NewTable("users")
+		table.AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey())
+		index := gomb.NewIndex("idx_users_id").OnTable("users").AddColumn("id")
+
+		require.NoError(t, gomb.ExecAll(ctx, db, table, index))
+		require.Len(t, fake.execs, 2)
+		assert.Equal(t, "CREATE TABLE users (id SERIAL PRIMARY KEY)", fake.execs[0].query)
+		assert.Equal(t, "CREATE INDEX idx_users_id ON users (id)", fake.execs[1].query)
+	})
+
+	t.Run("Executes Table Comment Separately", func(t *testing.T) {
+		db, fake := openFakeDB(t)
+
+		table := gomb.NewTable("users")
+		table.Comment = "user's accounts"
+		table.AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey())
+		alter := gomb.NewAlterTable("users").AddColumn(gomb.NewColumn("name").SetDataType(gomb.BooleanType))
+		alter.Comment = "accounts"
+
+		statements := append(table.Statements(), alter.Statements()...)
+		require.NoError(t, gomb.ExecAll(ctx, db, statements...))
+		require.Len(t, fake.execs, 4)
+		assert.Equal(t, "CREATE TABLE users (id SERIAL PRIMARY KEY)", fake.execs[0].query)
+		assert.Equal(t, "COMMENT ON TABLE users IS 'user''s accounts'", fake.execs[1].query)
+		assert.Equal(t, "ALTER TABLE users ADD COLUMN name BOOLEAN", fake.execs[2].query)
+		assert.Equal(t, "COMMENT ON TABLE users IS 'accounts'", fake.execs[3].query)
+
+		sql, _, err := gomb.NewTableComment("users", `a\b`).Build(gomb.MySQLDialect)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE users COMMENT = 'a\\b'`, sql)
+		sql, _, err = gomb.NewTableComment("users", "").Build(gomb.PostgresDialect)
+		require.NoError(t, err)
+		assert.Equal(t, "COMMENT ON TABLE users IS NULL", sql)
+		sql, _, err = gomb.NewTableComment("users", "accounts").Build(gomb.SQLiteDialect)
+		require.NoError(t, err)
+		assert.Empty(t, sql)
+	})
+
+	t.Run("Skips Table Comment On SQLite", func(t *testing.T) {
+		db, fake := openFakeDB(t)
+
+		table := gomb.NewTable("users")
+		table.Comment = "accounts"
+		table.AddColumn(gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetPrimaryKey())
+
+		require.NoError(t, gomb.ExecAll(ctx, gomb.WithDialect(db, gomb.SQLiteDialect), table.Statements()...))
+		require.Len(t, fake.execs, 1)
+		assert.Equal(t, "CREATE TABLE users (id INTEGER PRIMARY KEY)", fake.execs[0].query)
+	})
+
+	t.Run("Wraps Driver Errors", func(t *testing.T) {
+		db, fake := openFakeDB(t)
+		driverErr := errors.New("relation \"users\" already exists")
+		fake.execErr = driverErr
+
+		_, err := gomb.Exec(ctx, db, gomb.NewDropTable("users"))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, driverErr)
+
+		var execErr *gomb.ExecError
+		require.ErrorAs(t, err, &execErr)
+		assert.Equal(t, "*gomb.DropTable", execErr.Builder)
+		assert.Equal(t, "DROP TABLE IF EXISTS users", execErr.Statement)
+	})
+
+	t.Run("Render Errors Are Not Executed", func(t *testing.T) {
+		db, fake := openFakeDB(t)
+
+		_, err := gomb.Exec(ctx, db, gomb.NewTable("empty"))
+		assert.Error(t, err)
+		assert.Empty(t, fake.execs)
+	})
+
+	t.Run("Dialect Of Connection", func(t *testing.T) {
+		db, _ := openFakeDB(t)
+		assert.Equal(t, gomb.PostgresDialect, gomb.DialectOf(db))
+		assert.Equal(t, gomb.MySQLDialect, gomb.DialectOf(gomb.WithDialect(db, gomb.MySQLDialect)))
+
+		_, err := gomb.Exec(ctx, gomb.WithDialect(db, "oracle"), gomb.NewDropTable("users"))
+		assert.Error(t, err)
+	})
+}
+
+func TestQuery(t *testing.T) {
+	ctx := context.Background()
+
+	table := gomb.NewTable("users")
+	table.AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey())
+	table.AddColumn(gomb.NewColumn("name").SetDataType(gomb.StringType))
+	table.AddColumn(gomb.NewColumn("balance").SetDataType(gomb.DecimalType))
+	table.AddColumn(gomb.NewColumn("active").SetDataType(gomb.BooleanType))
+	table.AddColumn(gomb.NewColumn("born_on").SetDataType(gomb.DateType))
+
+	t.Run("Converts Values By Data Type", func(t *testing.T) {
+		db, _ := openFakeDB(t, fakeResponse{
+			match:   "SELECT",
+			columns: []string{"id", "name", "balance", "active", "born_on", "extra"},
+			rows: [][]driver.Value{
+				{[]byte("7"), []byte("ada"), []byte("10.50"), "t", "1990-05-17", []byte("raw")},
+				{int64(8), nil, float64(3), int64(0), time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+			},
+		})
+
+		rows, err := gomb.Query(ctx, db, table, "SELECT * FROM users")
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		assert.Equal(t, map[string]any{
+			"id": int64(7), "name": "ada", "balance": "10.50", "active": true,
+			"born_on": time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC), "extra": "raw",
+		}, rows[0])
+		assert.Equal(t, map[string]any{
+			"id": int64(8), "name": nil, "balance": "3", "active": false,
+			"born_on": time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC), "extra": nil,
+		}, rows[1])
+	})
+
+	t.Run("Decimal Keeps Precision", func(t *testing.T) {
+		db, _ := openFakeDB(t, fakeResponse{
+			match:   "SELECT",
+			columns: []string{"balance"},
+			rows:    [][]driver.Value{{[]byte("12345678901234567890.12")}, {"ten"}},
+		})
+
+		_, err := gomb.Query(ctx, db, table, "SELECT balance FROM users")
+		assert.ErrorContains(t, err, "column balance")
+
+		db, _ = openFakeDB(t, fakeResponse{
+			match:   "SELECT",
+			columns: []string{"balance"},
+			rows:    [][]driver.Value{{[]byte("12345678901234567890.12")}},
+		})
+		rows, err := gomb.Query(ctx, db, table, "SELECT balance FROM users")
+		require.NoError(t, err)
+		assert.Equal(t, "12345678901234567890.12", rows[0]["balance"])
+	})
+
+	t.Run("Conversion Error", func(t *testing.T) {
+		db, _ := openFakeDB(t, fakeResponse{
+			match:   "SELECT",
+			columns: []string{"id"},
+			rows:    [][]driver.Value{{"not-a-number"}},
+		})
+
+		_, err := gomb.Query(ctx, db, table, "SELECT id FROM users")
+		assert.ErrorContains(t, err, "column id")
+	})
+
+	t.Run("Query Error", func(t *testing.T) {
+		db, _ := openFakeDB(t, fakeResponse{match: "SELECT", err: errors.New("syntax error")})
+
+		_, err := gomb.Query(ctx, db, table, "SELECT FROM")
+		var execErr *gomb.ExecError
+		assert.ErrorAs(t, err, &execErr)
+	})
+}
@@ -0,0 +1,149 @@
+package gomb_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildExpr(t *testing.T) {
+	condition := gomb.And(
+		gomb.Eq("status", "active"),
+		gomb.Or(gomb.Gt("total", 100), gomb.In("region", "eu", "us")),
+		gomb.IsNull("deleted_at"),
+	)
+
+	tests := []struct {
+		name     string
+		style    gomb.PlaceholderStyle
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "Question",
+			style:    gomb.QuestionPlaceholder,
+			wantSQL:  "(status = ? AND (total > ? OR region IN (?, ?)) AND deleted_at IS NULL)",
+			wantArgs: []any{"active", 100, "eu", "us"},
+		},
+		{
+			name:     "Dollar",
+			style:    gomb.DollarPlaceholder,
+			wantSQL:  "(status = $1 AND (total > $2 OR region IN ($3, $4)) AND deleted_at IS NULL)",
+			wantArgs: []any{"active", 100, "eu", "us"},
+		},
+		{
+			name:     "At",
+			style:    gomb.AtPlaceholder,
+			wantSQL:  "(status = @p1 AND (total > @p2 OR region IN (@p3, @p4)) AND deleted_at IS NULL)",
+			wantArgs: []any{"active", 100, "eu", "us"},
+		},
+		{
+			name:    "Named",
+			style:   gomb.NamedPlaceholder,
+			wantSQL: "(status = :status AND (total > :total OR region IN (:region, :region_2)) AND deleted_at IS NULL)",
+			wantArgs: []any{
+				sql.Named("status", "active"), sql.Named("total", 100),
+				sql.Named("region", "eu"), sql.Named("region_2", "us"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := gomb.BuildExpr(condition, tt.style)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+
+	t.Run("Raw With Arguments", func(t *testing.T) {
+		sql, args, err := gomb.BuildExpr(gomb.Raw("price * ? > 'a?b'", 1.1), gomb.DollarPlaceholder)
+		assert.NoError(t, err)
+		assert.Equal(t, "price * $1 > 'a?b'", sql)
+		assert.Equal(t, []any{1.1}, args)
+	})
+
+	t.Run("Raw Argument Count", func(t *testing.T) {
+		_, _, err := gomb.BuildExpr(gomb.Raw("price * ? > ?", 1.1), gomb.QuestionPlaceholder)
+		assert.ErrorContains(t, err, "2 placeholders but 1 arguments")
+		_, _, err = gomb.BuildExpr(gomb.Raw("price > 0", 1.1), gomb.QuestionPlaceholder)
+		assert.Error(t, err)
+
+		_, _, err = gomb.NewUpdate("orders").Set("price", gomb.Raw("price * ?")).Build(gomb.PostgresDialect)
+		assert.Error(t, err)
+		_, _, err = gomb.NewDelete("orders").Where(gomb.Raw("id = ?", 1, 2)).Build(gomb.MySQLDialect)
+		assert.Error(t, err)
+	})
+
+	t.Run("Empty In", func(t *testing.T) {
+		sql, args, err := gomb.BuildExpr(gomb.And(gomb.Eq("status", "open"), gomb.In("region")), gomb.QuestionPlaceholder)
+		assert.NoError(t, err)
+		assert.Equal(t, "(status = ? AND FALSE)", sql)
+		assert.Equal(t, []any{"open"}, args)
+	})
+
+	t.Run("Dialect Placeholder", func(t *testing.T) {
+		assert.Equal(t, gomb.DollarPlaceholder, gomb.PostgresDialect.Placeholder())
+		assert.Equal(t, gomb.QuestionPlaceholder, gomb.MySQLDialect.Placeholder())
+		assert.Equal(t, gomb.QuestionPlaceholder, gomb.SQLiteDialect.Placeholder())
+	})
+}
+
+func TestInlineExpr(t *testing.T) {
+	t.Run("Escapes Literals", func(t *testing.T) {
+		sql := gomb.InlineExpr(gomb.And(
+			gomb.Eq("name", "O'Brien"),
+			gomb.Between("created_at", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), gomb.Raw("NOW()")),
+			gomb.Not(gomb.Eq("active", false)),
+		))
+		assert.Equal(t, "(name = 'O''Brien' AND created_at BETWEEN '2024-01-01 00:00:00Z' AND NOW() AND NOT (active = FALSE))", sql)
+	})
+
+	t.Run("Index Predicate", func(t *testing.T) {
+		idx := gomb.NewIndex("idx_orders_open").OnTable("orders").AddColumn("id").
+			SetWhereExpr(gomb.And(gomb.Eq("status", "open'; DROP TABLE orders; --"), gomb.Gt("total", 1000)))
+
+		sql, args, err := idx.Build(gomb.PostgresDialect)
+		assert.NoError(t, err)
+		assert.Empty(t, args)
+		assert.Equal(t, "CREATE INDEX idx_orders_open ON orders (id) WHERE (status = 'open''; DROP TABLE orders; --' AND total > 1000)", sql)
+	})
+
+	t.Run("Check Constraint", func(t *testing.T) {
+		col := gomb.NewColumn("status").SetDataType(gomb.StringType).SetCheckExpr(gomb.In("status", "draft", "sent"))
+
+		sql, err := col.ToSQL()
+		assert.NoError(t, err)
+		assert.Equal(t, "status VARCHAR CHECK (status IN ('draft', 'sent'))", sql)
+	})
+
+	t.Run("MySQL Backslashes", func(t *testing.T) {
+		payload := `a\' OR 1=1 -- `
+		assert.Equal(t, `'a\\'' OR 1=1 -- '`, gomb.QuoteStringFor(gomb.MySQLDialect, payload))
+		assert.Equal(t, `'a\'' OR 1=1 -- '`, gomb.QuoteStringFor(gomb.PostgresDialect, payload))
+		assert.Equal(t, `'a\'' OR 1=1 -- '`, gomb.QuoteStringFor(gomb.SQLiteDialect, payload))
+
+		table := gomb.NewTable("notes").AddColumn(
+			gomb.NewColumn("body").SetDataType(gomb.StringType).SetLength(50).
+				SetDefault(payload).
+				SetCheckExpr(gomb.Ne("body", payload)).
+				SetComment(payload))
+		sql, args, err := table.Build(gomb.MySQLDialect)
+		assert.NoError(t, err)
+		assert.Empty(t, args)
+		assert.Equal(t, `CREATE TABLE notes (body VARCHAR(50) DEFAULT 'a\\'' OR 1=1 -- ' CHECK (body <> 'a\\'' OR 1=1 -- ') COMMENT 'a\\'' OR 1=1 -- ')`, sql)
+
+		sql, _, err = table.Build(gomb.PostgresDialect)
+		assert.NoError(t, err)
+		assert.Contains(t, sql, `DEFAULT 'a\'' OR 1=1 -- '`)
+
+		sql, _, err = gomb.NewAlterTable("notes").AddColumn(
+			gomb.NewColumn("title").SetDataType(gomb.StringType).SetLength(50).SetDefault(payload)).Build(gomb.MySQLDialect)
+		assert.NoError(t, err)
+		assert.Equal(t, `ALTER TABLE notes ADD COLUMN title VARCHAR(50) DEFAULT 'a\\'' OR 1=1 -- '`, sql)
+	})
+}
@@ -0,0 +1,177 @@
+package gomb_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is an in-memory database/sql driver answering queries with canned rows,
+// so database code can be tested offline
+type fakeDriver struct{}
+
+// fakeResponse answers every query containing match
+type fakeResponse struct {
+	match   string
+	args    []driver.Value // when set, the query arguments must be equal
+	columns []string
+	rows    [][]driver.Value
+	err     error
+}
+
+// fakeDB records executed statements and serves canned query responses
+type fakeDB struct {
+	mu        sync.Mutex
+	responses []fakeResponse
+	execs     []fakeExec
+	execErr   error
+	affected  []int64 // rows affected by successive execs, 1 once exhausted
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+}
+
+var (
+	fakeDBs   sync.Map
+	fakeCount int
+	fakeMu    sync.Mutex
+)
+
+func init() {
+	sql.Register("gombfake", fakeDriver{})
+}
+
+// openFakeDB opens a *sql.DB backed by a new fakeDB
+func openFakeDB(t *testing.T, responses ...fakeResponse) (*sql.DB, *fakeDB) {
+	t.Helper()
+
+	fakeMu.Lock()
+	fakeCount++
+	dsn := fmt.Sprintf("fake-%d", fakeCount)
+	fakeMu.Unlock()
+
+	fake := &fakeDB{responses: responses}
+	fakeDBs.Store(dsn, fake)
+
+	db, err := sql.Open("gombfake", dsn)
+	if err != nil {
+		t.Fatalf("open fake db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	fake, ok := fakeDBs.Load(dsn)
+	if !ok {
+		return nil, fmt.Errorf("unknown fake dsn %s", dsn)
+	}
+	return &fakeConn{db: fake.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	db    *fakeDB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if s.db.execErr != nil {
+		return nil, s.db.execErr
+	}
+	s.db.execs = append(s.db.execs, fakeExec{query: s.query, args: args})
+	if len(s.db.affected) > 0 {
+		rows := s.db.affected[0]
+		s.db.affected = s.db.affected[1:]
+		return driver.RowsAffected(rows), nil
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	for _, response := range s.db.responses {
+		if !strings.Contains(s.query, response.match) {
+			continue
+		}
+		if response.args != nil && fmt.Sprint(response.args) != fmt.Sprint(args) {
+			continue
+		}
+		if response.err != nil {
+			return nil, response.err
+		}
+		return &fakeRows{columns: response.columns, rows: response.rows}, nil
+	}
+	return &fakeRows{}, nil
+}
+
+// QueryContext is implemented so cancelled contexts are reported like a real driver
+func (s *fakeStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return s.Query(values)
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *fakeRows) Columns() []string {
+	if r.columns == nil && len(r.rows) > 0 {
+		columns := make([]string, len(r.rows[0]))
+		for i := range columns {
+			columns[i] = fmt.Sprintf("c%d", i)
+		}
+		return columns
+	}
+	return r.columns
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
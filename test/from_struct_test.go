@@ -0,0 +1,93 @@
+package gomb_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type auditFields struct {
+	CreatedAt time.Time  `gomb:"created_at,notnull"`
+	UpdatedAt *time.Time `gomb:"updated_at"`
+}
+
+type UserAccount struct {
+	ID       int64          `gomb:"id,type=serial,pk"`
+	Username string         `gomb:"username,length=50,notnull,unique"`
+	Email    string         `gomb:",length=255"`
+	Balance  float64        `gomb:"balance,precision=10,scale=2"`
+	IsActive bool           `gomb:"is_active,default=true"`
+	Tags     string         `gomb:"tags,length=40,default=a,b"`
+	Nickname sql.NullString `gomb:"nickname,length=30"`
+	Password string         `gomb:"-"`
+	internal string
+	auditFields
+}
+
+type orderLine struct {
+	OrderID  int `gomb:"order_id,notnull,references=orders(id)"`
+	Quantity int
+}
+
+func (orderLine) TableName() string { return "order_lines" }
+
+func TestTableFromStruct(t *testing.T) {
+	t.Run("Tagged Struct With Embedded Fields", func(t *testing.T) {
+		table, err := gomb.TableFromStruct(&UserAccount{})
+		require.NoError(t, err)
+		assert.Equal(t, "user_account", table.Name)
+
+		sql, errors := table.ToSQL()
+		assert.Empty(t, errors)
+		assert.Equal(t, "CREATE TABLE user_account (id SERIAL PRIMARY KEY, username VARCHAR(50) NOT NULL UNIQUE, email VARCHAR(255) NOT NULL, balance DECIMAL(10,2) NOT NULL, is_active BOOLEAN NOT NULL DEFAULT TRUE, tags VARCHAR(40) NOT NULL DEFAULT 'a,b', nickname VARCHAR(30) NULL, created_at TIMESTAMP NOT NULL, updated_at TIMESTAMP NULL)", sql)
+	})
+
+	t.Run("Table Name Override And Untagged Fields", func(t *testing.T) {
+		table, err := gomb.TableFromStruct(orderLine{})
+		require.NoError(t, err)
+
+		sql, errors := table.ToSQL()
+		assert.Empty(t, errors)
+		assert.Equal(t, "CREATE TABLE order_lines (order_id INTEGER NOT NULL REFERENCES orders(id), quantity INTEGER NOT NULL)", sql)
+	})
+
+	t.Run("Nullability", func(t *testing.T) {
+		table, err := gomb.TableFromStruct(struct {
+			Name string
+			Nick *string
+		}{})
+		require.NoError(t, err)
+		assert.Equal(t, gomb.NullabilityNotNull, table.Columns[0].Nullability())
+		assert.Equal(t, gomb.NullabilityNull, table.Columns[1].Nullability())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			value any
+		}{
+			{name: "Not a struct", value: 42},
+			{name: "Nullable field marked notnull", value: struct {
+				Name *string `gomb:"name,notnull"`
+			}{}},
+			{name: "Unsupported field type", value: struct {
+				Tags []string
+			}{}},
+			{name: "Unknown tag option", value: struct {
+				Name string `gomb:"name,indexed"`
+			}{}},
+			{name: "No fields", value: struct{}{}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := gomb.TableFromStruct(tc.value)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package gomb_test
+
+import (
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFunction(t *testing.T) {
+	sql, err := gomb.NewFunction("log_truncate").SetSchema("audit").SetReturns("TRIGGER").
+		SetBody("BEGIN RAISE NOTICE 'truncated'; RETURN NULL; END;").ToSQL()
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE FUNCTION audit.log_truncate() RETURNS TRIGGER LANGUAGE plpgsql AS $$BEGIN RAISE NOTICE 'truncated'; RETURN NULL; END;$$", sql)
+
+	t.Run("Body Containing Dollar Quotes", func(t *testing.T) {
+		sql, err := gomb.NewFunction("f").SetReturns("TEXT").SetLanguage("sql").SetBody("SELECT $$x$$").ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE FUNCTION f() RETURNS TEXT LANGUAGE sql AS $gomb0$SELECT $$x$$$gomb0$", sql)
+	})
+
+	t.Run("Missing Body", func(t *testing.T) {
+		_, err := gomb.NewFunction("f").SetReturns("TRIGGER").ToSQL()
+		assert.ErrorIs(t, err, gomb.ErrRequired)
+	})
+}
+
+func TestFunctionArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement interface{ ToSQL() (string, error) }
+		wantSQL   string
+		wantErr   bool
+	}{
+		{
+			name: "Arguments With Modes And Defaults",
+			statement: gomb.NewFunction("order_total").SetSchema("sales").SetOrReplace().
+				AddArg("order_id", gomb.IntegerType).
+				AddArgWithMode(gomb.OutArg, "total", gomb.DecimalType).
+				AddArgWithDefault("currency", gomb.StringType, "EUR").
+				SetReturns(gomb.DecimalType).SetLanguage("sql").SetVolatility(gomb.Stable).SetSecurityDefiner().
+				SetBody("SELECT sum(amount) FROM order_items WHERE order_id = $1"),
+			wantSQL: "CREATE OR REPLACE FUNCTION sales.order_total(order_id INTEGER, OUT total DECIMAL, currency VARCHAR DEFAULT 'EUR') RETURNS DECIMAL STABLE LANGUAGE sql SECURITY DEFINER AS $$SELECT sum(amount) FROM order_items WHERE order_id = $1$$",
+		},
+		{
+			name: "Returns Type Outside The Catalogue",
+			statement: gomb.NewFunction("active_users").SetReturns("SETOF users").SetLanguage("sql").
+				SetVolatility(gomb.Immutable).SetBody("SELECT * FROM users WHERE active"),
+			wantSQL: "CREATE FUNCTION active_users() RETURNS SETOF users IMMUTABLE LANGUAGE sql AS $$SELECT * FROM users WHERE active$$",
+		},
+		{
+			name: "Required Argument After Default",
+			statement: gomb.NewProcedure("archive_orders").
+				AddArgWithDefault("before", gomb.DateType, gomb.Raw("CURRENT_DATE")).
+				AddArgWithMode(gomb.InOutArg, "moved", gomb.IntegerType).
+				SetBody("BEGIN moved := 0; END;"),
+			wantErr: true,
+		},
+		{
+			name: "Procedure With Defaults Last",
+			statement: gomb.NewProcedure("archive_orders").SetOrReplace().
+				AddArgWithMode(gomb.InOutArg, "moved", gomb.IntegerType).
+				AddArgWithDefault("before", gomb.DateType, gomb.Raw("CURRENT_DATE")).
+				SetBody("BEGIN moved := 0; END;"),
+			wantSQL: "CREATE OR REPLACE PROCEDURE archive_orders(INOUT moved INTEGER, before DATE DEFAULT CURRENT_DATE) LANGUAGE plpgsql AS $$BEGIN moved := 0; END;$$",
+		},
+		{
+			name:      "Procedure Without Arguments",
+			statement: gomb.NewProcedure("p").SetBody("BEGIN END;"),
+			wantSQL:   "CREATE PROCEDURE p() LANGUAGE plpgsql AS $$BEGIN END;$$",
+		},
+		{
+			name:      "Missing Return Type",
+			statement: gomb.NewFunction("f").SetBody("SELECT 1"),
+			wantErr:   true,
+		},
+		{
+			name:      "Invalid Volatility",
+			statement: gomb.NewFunction("f").SetReturns(gomb.IntegerType).SetVolatility("SOMETIMES").SetBody("SELECT 1"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, err := tt.statement.ToSQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
+
+func TestDropFunction(t *testing.T) {
+	sql, err := gomb.NewDropFunction("order_total", gomb.IntegerType, gomb.StringType).SetSchema("sales").SetIfExists().SetCascade().ToSQL()
+	require.NoError(t, err)
+	assert.Equal(t, "DROP FUNCTION IF EXISTS sales.order_total(INTEGER, VARCHAR) CASCADE", sql)
+
+	sql, _, err = gomb.NewDropProcedure("archive_orders", gomb.IntegerType, gomb.DateType).SetRestrict().Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "DROP PROCEDURE archive_orders(INTEGER, DATE) RESTRICT", sql)
+
+	_, _, err = gomb.NewDropFunction("order_total").Build(gomb.SQLiteDialect)
+	assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+}
@@ -1,6 +1,8 @@
 package gomb_test
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	gomb "github.com/nandrechetan/gomb/internal"
@@ -351,6 +353,283 @@ func TestDropIndex(t *testing.T) {
 	})
 }
 
+func TestIndexColumns(t *testing.T) {
+	testCases := []struct {
+		name     string
+		index    *gomb.Index
+		expected string
+		err      error
+	}{
+		{
+			name: "Sort Order And Nulls",
+			index: gomb.NewIndex("idx_orders_recent").OnTable("orders").AddColumn("customer_id").
+				AddIndexColumn(gomb.NewIndexColumn("created_at").SetDesc().SetNullsLast()),
+			expected: "CREATE INDEX idx_orders_recent ON orders (customer_id, created_at DESC NULLS LAST)",
+		},
+		{
+			name: "Collation And Operator Class",
+			index: gomb.NewIndex("idx_users_name").OnTable("users").
+				AddIndexColumn(gomb.NewIndexColumn("name").SetCollation(`"C"`).SetOpClass("text_pattern_ops").SetAsc()),
+			expected: `CREATE INDEX idx_users_name ON users (name COLLATE "C" text_pattern_ops ASC)`,
+		},
+		{
+			name:     "Expression Key",
+			index:    gomb.NewIndex("idx_users_email_domain").OnTable("users").AddIndexColumn(gomb.NewIndexExpression("split_part(email, '@', 2)").SetNullsFirst()),
+			expected: "CREATE INDEX idx_users_email_domain ON users ((split_part(email, '@', 2)) NULLS FIRST)",
+		},
+		{
+			name: "If Not Exists Only Nulls Not Distinct",
+			index: gomb.NewIndex("idx_events_key").OnTable("events").AddColumn("key").
+				SetUnique().SetIfNotExists().SetOnly().SetNullsNotDistinct(),
+			expected: "CREATE UNIQUE INDEX IF NOT EXISTS idx_events_key ON ONLY events (key) NULLS NOT DISTINCT",
+		},
+		{
+			name:  "Nulls Not Distinct Requires Unique",
+			index: gomb.NewIndex("idx_events_key").OnTable("events").AddColumn("key").SetNullsNotDistinct(),
+			err:   gomb.ErrInvalidOption,
+		},
+		{
+			name:  "Name And Expression",
+			index: gomb.NewIndex("idx_users").OnTable("users").AddIndexColumn(&gomb.IndexColumn{Name: "email", Expression: "lower(email)"}),
+			err:   gomb.ErrInvalidOption,
+		},
+		{
+			name:  "Invalid Sort Order",
+			index: gomb.NewIndex("idx_users").OnTable("users").AddIndexColumn(&gomb.IndexColumn{Name: "email", Order: "UP"}),
+			err:   gomb.ErrInvalidOption,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, err := tc.index.ToSQL()
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Errorf("Expected error %v, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if sql != tc.expected {
+				t.Errorf("Expected SQL: %s, got: %s", tc.expected, sql)
+			}
+		})
+	}
+
+	t.Run("JSON Keeps Plain Columns As Strings", func(t *testing.T) {
+		idx := gomb.NewIndex("idx_orders_recent").OnTable("orders").AddColumn("customer_id").
+			AddIndexColumn(gomb.NewIndexColumn("created_at").SetDesc())
+
+		data, err := json.Marshal(idx)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := `{"name":"idx_orders_recent","table":"orders","columns":["customer_id",{"name":"created_at","order":"DESC"}]}`
+		if string(data) != expected {
+			t.Errorf("Expected JSON: %s, got: %s", expected, data)
+		}
+
+		var decoded gomb.Index
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		sql, err := decoded.ToSQL()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if sql != "CREATE INDEX idx_orders_recent ON orders (customer_id, created_at DESC)" {
+			t.Errorf("Unexpected SQL after round trip: %s", sql)
+		}
+	})
+}
+
+func TestIndexDialects(t *testing.T) {
+	testCases := []struct {
+		name     string
+		index    *gomb.Index
+		dialect  gomb.Dialect
+		expected string
+		err      error
+	}{
+		{
+			name: "Postgres Options In Grammar Order",
+			index: gomb.NewIndex("idx_orders_open").OnTable("orders").AddColumn("customer_id").SetMethod(gomb.BTreeIndex).
+				SetWhere("status = 'open'").AddWithOption("fillfactor=70").SetTablespace("fast_ssd"),
+			dialect:  gomb.PostgresDialect,
+			expected: "CREATE INDEX idx_orders_open ON orders USING btree (customer_id) WITH (fillfactor=70) TABLESPACE fast_ssd WHERE status = 'open'",
+		},
+		{
+			name:     "MySQL Method After Columns",
+			index:    gomb.NewIndex("idx_sessions_token").OnTable("sessions").AddColumn("token").SetMethod("HASH"),
+			dialect:  gomb.MySQLDialect,
+			expected: "CREATE INDEX idx_sessions_token ON sessions (token) USING HASH",
+		},
+		{
+			name:     "MySQL Full Text",
+			index:    gomb.NewIndex("idx_posts_body").OnTable("posts").AddColumn("title").AddColumn("body").SetMethod(gomb.FullTextIndex),
+			dialect:  gomb.MySQLDialect,
+			expected: "CREATE FULLTEXT INDEX idx_posts_body ON posts (title, body)",
+		},
+		{
+			name:     "MySQL Drops Concurrently",
+			index:    gomb.NewIndex("idx_users_email").OnTable("users").AddColumn("email").SetConcurrently(),
+			dialect:  gomb.MySQLDialect,
+			expected: "CREATE INDEX idx_users_email ON users (email)",
+		},
+		{
+			name:     "SQLite Partial Index Without Method",
+			index:    gomb.NewIndex("idx_users_email").OnTable("users").AddColumn("email").SetMethod(gomb.BTreeIndex).SetIfNotExists().SetWhere("deleted_at IS NULL"),
+			dialect:  gomb.SQLiteDialect,
+			expected: "CREATE INDEX IF NOT EXISTS idx_users_email ON users (email) WHERE deleted_at IS NULL",
+		},
+		{
+			name:    "Unknown Method",
+			index:   gomb.NewIndex("idx_users_email").OnTable("users").AddColumn("email").SetMethod("bloom"),
+			dialect: gomb.PostgresDialect,
+			err:     gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:    "Gin On MySQL",
+			index:   gomb.NewIndex("idx_products_metadata").OnTable("products").AddColumn("metadata").SetMethod(gomb.GinIndex),
+			dialect: gomb.MySQLDialect,
+			err:     gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:    "Full Text On Postgres",
+			index:   gomb.NewIndex("idx_posts_body").OnTable("posts").AddColumn("body").SetMethod(gomb.FullTextIndex),
+			dialect: gomb.PostgresDialect,
+			err:     gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:    "Unique Full Text",
+			index:   gomb.NewIndex("idx_posts_body").OnTable("posts").AddColumn("body").SetMethod(gomb.FullTextIndex).SetUnique(),
+			dialect: gomb.MySQLDialect,
+			err:     gomb.ErrInvalidOption,
+		},
+		{
+			name:    "Include On MySQL",
+			index:   gomb.NewIndex("idx_orders_customer").OnTable("orders").AddColumn("customer_id").AddIncludeColumn("total"),
+			dialect: gomb.MySQLDialect,
+			err:     gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:    "Where On MySQL",
+			index:   gomb.NewIndex("idx_orders_open").OnTable("orders").AddColumn("id").SetWhere("status = 'open'"),
+			dialect: gomb.MySQLDialect,
+			err:     gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:    "Operator Class On SQLite",
+			index:   gomb.NewIndex("idx_users_name").OnTable("users").AddIndexColumn(gomb.NewIndexColumn("name").SetOpClass("text_pattern_ops")),
+			dialect: gomb.SQLiteDialect,
+			err:     gomb.ErrUnsupportedDialect,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, _, err := tc.index.Build(tc.dialect)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Errorf("Expected error %v, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if sql != tc.expected {
+				t.Errorf("Expected SQL: %s, got: %s", tc.expected, sql)
+			}
+		})
+	}
+}
+
+func TestIndexStatementDialects(t *testing.T) {
+	testCases := []struct {
+		name      string
+		statement gomb.Statement
+		dialect   gomb.Dialect
+		expected  string
+		err       error
+	}{
+		{
+			name:      "MySQL Drop Index On Table",
+			statement: gomb.NewDropIndex("idx_users_email").OnTable("users").SetSchema("auth").SetConcurrently(),
+			dialect:   gomb.MySQLDialect,
+			expected:  "DROP INDEX idx_users_email ON auth.users",
+		},
+		{
+			name:      "SQLite Drops Concurrently",
+			statement: gomb.NewDropIndex("idx_users_email").SetIfExists().SetConcurrently(),
+			dialect:   gomb.SQLiteDialect,
+			expected:  "DROP INDEX IF EXISTS idx_users_email",
+		},
+		{
+			name:      "MySQL Drop Index Without Table",
+			statement: gomb.NewDropIndex("idx_users_email"),
+			dialect:   gomb.MySQLDialect,
+			err:       gomb.ErrRequired,
+		},
+		{
+			name:      "MySQL Drop Index If Exists",
+			statement: gomb.NewDropIndex("idx_users_email").OnTable("users").SetIfExists(),
+			dialect:   gomb.MySQLDialect,
+			err:       gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:      "SQLite Drop Index Cascade",
+			statement: gomb.NewDropIndex("idx_users_email").SetCascade(),
+			dialect:   gomb.SQLiteDialect,
+			err:       gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:      "MySQL Rename Index",
+			statement: gomb.NewRenameIndex("idx_old", "idx_new").OnTable("users"),
+			dialect:   gomb.MySQLDialect,
+			expected:  "ALTER TABLE users RENAME INDEX idx_old TO idx_new",
+		},
+		{
+			name:      "MySQL Rename Index Without Table",
+			statement: gomb.NewRenameIndex("idx_old", "idx_new"),
+			dialect:   gomb.MySQLDialect,
+			err:       gomb.ErrRequired,
+		},
+		{
+			name:      "SQLite Rename Index",
+			statement: gomb.NewRenameIndex("idx_old", "idx_new"),
+			dialect:   gomb.SQLiteDialect,
+			err:       gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:      "MySQL Index Tablespace",
+			statement: gomb.NewSetIndexTablespace("idx_users_email", "fast_ssd"),
+			dialect:   gomb.MySQLDialect,
+			err:       gomb.ErrUnsupportedDialect,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, _, err := tc.statement.Build(tc.dialect)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Errorf("Expected error %v, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if sql != tc.expected {
+				t.Errorf("Expected SQL: %s, got: %s", tc.expected, sql)
+			}
+		})
+	}
+}
+
 func TestRenameIndex(t *testing.T) {
 	t.Run("Basic Rename Index", func(t *testing.T) {
 		rename := gomb.NewRenameIndex("idx_old", "idx_new")
@@ -436,4 +715,69 @@ func TestReindex(t *testing.T) {
 			t.Errorf("Expected SQL: %s, got: %s", expected, sql)
 		}
 	})
+	t.Run("Reindex Options", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			reindex  *gomb.ReindexOperation
+			dialect  gomb.Dialect
+			expected string
+			err      error
+		}{
+			{
+				name:     "Concurrently Follows Target",
+				reindex:  gomb.NewReindex(gomb.ReindexIndex, "idx_users_email").SetSchema("auth").SetConcurrently(),
+				dialect:  gomb.PostgresDialect,
+				expected: "REINDEX INDEX CONCURRENTLY auth.idx_users_email",
+			},
+			{
+				name:     "Options List",
+				reindex:  gomb.NewReindex(gomb.ReindexTable, "orders").SetVerbose().SetConcurrently().SetTablespace("fast_ssd"),
+				dialect:  gomb.PostgresDialect,
+				expected: "REINDEX (VERBOSE, CONCURRENTLY, TABLESPACE fast_ssd) TABLE orders",
+			},
+			{
+				name:     "Lowercase Target",
+				reindex:  gomb.NewReindex("schema", "sales").SetVerbose(),
+				dialect:  gomb.PostgresDialect,
+				expected: "REINDEX (VERBOSE) SCHEMA sales",
+			},
+			{
+				name:     "Database Without Name",
+				reindex:  gomb.NewReindex(gomb.ReindexDatabase, ""),
+				dialect:  gomb.PostgresDialect,
+				expected: "REINDEX DATABASE",
+			},
+			{
+				name:     "SQLite",
+				reindex:  gomb.NewReindex(gomb.ReindexIndex, "idx_users_email"),
+				dialect:  gomb.SQLiteDialect,
+				expected: "REINDEX idx_users_email",
+			},
+			{name: "Invalid Target", reindex: gomb.NewReindex("FOO", "bar"), dialect: gomb.PostgresDialect, err: gomb.ErrInvalidOption},
+			{name: "Missing Name", reindex: gomb.NewReindex(gomb.ReindexTable, ""), dialect: gomb.PostgresDialect, err: gomb.ErrRequired},
+			{name: "System Concurrently", reindex: gomb.NewReindex(gomb.ReindexSystem, "").SetConcurrently(), dialect: gomb.PostgresDialect, err: gomb.ErrInvalidOption},
+			{name: "System Tablespace", reindex: gomb.NewReindex(gomb.ReindexSystem, "").SetTablespace("fast_ssd"), dialect: gomb.PostgresDialect, err: gomb.ErrInvalidOption},
+			{name: "Qualified Schema", reindex: gomb.NewReindex(gomb.ReindexSchema, "sales").SetSchema("other"), dialect: gomb.PostgresDialect, err: gomb.ErrInvalidOption},
+			{name: "SQLite Options", reindex: gomb.NewReindex(gomb.ReindexTable, "users").SetVerbose(), dialect: gomb.SQLiteDialect, err: gomb.ErrUnsupportedDialect},
+			{name: "MySQL", reindex: gomb.NewReindex(gomb.ReindexTable, "users"), dialect: gomb.MySQLDialect, err: gomb.ErrUnsupportedDialect},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				sql, _, err := tc.reindex.Build(tc.dialect)
+				if tc.err != nil {
+					if !errors.Is(err, tc.err) {
+						t.Errorf("Expected error %v, got: %v", tc.err, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if sql != tc.expected {
+					t.Errorf("Expected SQL: %s, got: %s", tc.expected, sql)
+				}
+			})
+		}
+	})
 }
@@ -0,0 +1,115 @@
+package gomb_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospect(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("PostgreSQL", func(t *testing.T) {
+		db, _ := openFakeDB(t,
+			fakeResponse{match: "FROM information_schema.tables", rows: [][]driver.Value{{"orders"}, {"users"}}},
+			fakeResponse{match: "FROM information_schema.columns", args: []driver.Value{"users"}, rows: [][]driver.Value{
+				{"id", "integer", int64(0), int64(32), int64(0), "NO", "nextval('users_id_seq'::regclass)", int64(1)},
+				{"email", "character varying", int64(255), int64(0), int64(0), "NO", nil, int64(0)},
+				{"status", "character varying", int64(20), int64(0), int64(0), "YES", "'active'::character varying", int64(0)},
+				{"balance", "numeric", int64(0), int64(10), int64(2), "YES", nil, int64(0)},
+				{"created_at", "timestamp without time zone", int64(0), int64(0), int64(0), "YES", "CURRENT_TIMESTAMP", int64(0)},
+			}},
+			fakeResponse{match: "FROM information_schema.columns", args: []driver.Value{"orders"}, rows: [][]driver.Value{
+				{"id", "integer", int64(0), int64(32), int64(0), "NO", nil, int64(0)},
+				{"user_id", "integer", int64(0), int64(32), int64(0), "YES", nil, int64(0)},
+				{"payload", "jsonb", int64(0), int64(0), int64(0), "YES", nil, int64(0)},
+			}},
+			fakeResponse{match: "FROM information_schema.table_constraints", args: []driver.Value{"users"}, rows: [][]driver.Value{
+				{"users_pkey", "PRIMARY KEY", "id", nil, nil},
+				{"users_email_key", "UNIQUE", "email", nil, nil},
+			}},
+			fakeResponse{match: "FROM information_schema.table_constraints", args: []driver.Value{"orders"}, rows: [][]driver.Value{
+				{"orders_pkey", "PRIMARY KEY", "id", nil, nil},
+				{"orders_user_id_fkey", "FOREIGN KEY", "user_id", "users", "id"},
+			}},
+			fakeResponse{match: "FROM pg_catalog.pg_index", args: []driver.Value{"users"}, rows: [][]driver.Value{
+				{"idx_users_status_created", "status", int64(0), "btree", int64(0)},
+				{"idx_users_status_created", "created_at", int64(0), "btree", int64(0)},
+				{"idx_users_lower_email", "lower((email)::text)", int64(1), "btree", int64(1)},
+			}},
+			fakeResponse{match: "FROM pg_catalog.pg_index", args: []driver.Value{"orders"}, rows: [][]driver.Value{
+				{"idx_orders_payload", "payload", int64(0), "gin", int64(0)},
+			}},
+		)
+
+		tables, err := gomb.Introspect(ctx, db, gomb.PostgresDialect)
+		require.NoError(t, err)
+		require.Len(t, tables, 2)
+
+		users := tables[1]
+		sql, errs := users.ToSQL()
+		assert.Empty(t, errs)
+		assert.Equal(t, "CREATE TABLE users (id SERIAL PRIMARY KEY NOT NULL, email VARCHAR(255) NOT NULL UNIQUE, status VARCHAR(20) DEFAULT 'active', balance DECIMAL(10,2), created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)", sql)
+
+		require.Len(t, users.Indexes, 2)
+		indexSQL, err := users.Indexes[0].ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE INDEX idx_users_status_created ON users (status, created_at)", indexSQL)
+		indexSQL, err = users.Indexes[1].ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE UNIQUE INDEX idx_users_lower_email ON users ((lower((email)::text)))", indexSQL)
+
+		orders := tables[0]
+		assert.Equal(t, "users(id)", orders.Columns[1].References)
+		sql, errs = orders.ToSQL()
+		assert.Empty(t, errs)
+		assert.Equal(t, "CREATE TABLE orders (id INTEGER PRIMARY KEY NOT NULL, user_id INTEGER REFERENCES users(id), payload jsonb)", sql)
+		assert.Equal(t, gomb.CustomType, orders.Columns[2].DataType)
+		indexSQL, err = orders.Indexes[0].ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE INDEX idx_orders_payload ON orders USING gin (payload)", indexSQL)
+	})
+
+	t.Run("SQLite", func(t *testing.T) {
+		db, _ := openFakeDB(t,
+			fakeResponse{match: "FROM sqlite_master", rows: [][]driver.Value{{"notes"}}},
+			fakeResponse{match: "FROM pragma_table_info(?1) ORDER BY cid", rows: [][]driver.Value{
+				{"id", "INTEGER", int64(0), int64(0), int64(0), "YES", nil, int64(0)},
+				{"title", "VARCHAR(100)", int64(0), int64(0), int64(0), "NO", nil, int64(0)},
+				{"score", "NUMERIC(5,2)", int64(0), int64(0), int64(0), "YES", "0", int64(0)},
+				{"done", "BOOLEAN", int64(0), int64(0), int64(0), "YES", "'f'", int64(0)},
+			}},
+			fakeResponse{match: "UNION ALL", rows: [][]driver.Value{
+				{"pk", "PRIMARY KEY", "id", nil, nil},
+			}},
+		)
+
+		tables, err := gomb.Introspect(ctx, db, gomb.SQLiteDialect)
+		require.NoError(t, err)
+		require.Len(t, tables, 1)
+
+		sql, errs := tables[0].ToSQL()
+		assert.Empty(t, errs)
+		assert.Equal(t, "CREATE TABLE notes (id INTEGER PRIMARY KEY, title VARCHAR(100) NOT NULL, score DECIMAL(5,2) DEFAULT 0, done BOOLEAN DEFAULT FALSE)", sql)
+		assert.Empty(t, tables[0].Indexes)
+	})
+
+	t.Run("Query Error", func(t *testing.T) {
+		db, _ := openFakeDB(t, fakeResponse{match: "FROM information_schema.tables", err: errors.New("permission denied")})
+
+		_, err := gomb.Introspect(ctx, db, gomb.MySQLDialect)
+		assert.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("Unsupported Dialect", func(t *testing.T) {
+		db, _ := openFakeDB(t)
+
+		_, err := gomb.Introspect(ctx, db, gomb.Dialect("oracle"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,125 @@
+package gomb_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateSchema = flag.Bool("update", false, "regenerate schema/gomb.schema.json")
+
+const schemaPath = "../schema/gomb.schema.json"
+
+type jsonSchemaDoc struct {
+	Defs map[string]struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+		AnyOf      []struct {
+			Required []string `json:"required"`
+		} `json:"anyOf"`
+	} `json:"$defs"`
+}
+
+func TestJSONSchema(t *testing.T) {
+	generated, err := gomb.JSONSchema()
+	require.NoError(t, err)
+
+	var doc jsonSchemaDoc
+	require.NoError(t, json.Unmarshal(generated, &doc))
+
+	t.Run("Committed Schema Is Up To Date", func(t *testing.T) {
+		if *updateSchema {
+			require.NoError(t, os.WriteFile(schemaPath, append(generated, '\n'), 0o644))
+		}
+
+		committed, err := os.ReadFile(schemaPath)
+		require.NoError(t, err)
+		assert.JSONEq(t, string(generated), string(committed), "schema is stale, run: go test ./test -run TestJSONSchema -update")
+	})
+
+	t.Run("Definitions Match Go Structs", func(t *testing.T) {
+		for name, value := range map[string]any{
+			"Table":          gomb.Table{},
+			"Column":         gomb.Column{},
+			"ColumnUpdate":   gomb.ColumnUpdate{},
+			"DefaultExpr":    gomb.DefaultExpr{},
+			"IndexColumn":    gomb.IndexColumn{},
+			"PartitionBy":    gomb.PartitionBy{},
+			"TableLike":      gomb.TableLike{},
+			"TablePrivilege": gomb.TablePrivilege{},
+			"TablePolicy":    gomb.TablePolicy{},
+		} {
+			assert.Equal(t, jsonFields(reflect.TypeOf(value)), propertyNames(doc.Defs[name].Properties), name)
+		}
+	})
+
+	t.Run("Index Definition Matches Serialized Index", func(t *testing.T) {
+		idx := gomb.NewIndex("idx_orders_customer").OnTable("orders").AddColumn("customer_id").
+			SetUnique().SetConcurrently().SetWhere("status = 'open'").SetSchema("sales").SetMethod("btree").
+			AddIncludeColumn("status").SetTablespace("fast_ssd").AddWithOption("fillfactor=70").
+			SetIfNotExists().SetOnly().SetNullsNotDistinct()
+
+		data, err := json.Marshal(idx)
+		require.NoError(t, err)
+
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &fields))
+		assert.Equal(t, propertyNames(fields), propertyNames(doc.Defs["Index"].Properties))
+	})
+
+	t.Run("Negative Identity Increment", func(t *testing.T) {
+		var identityInc map[string]any
+		require.NoError(t, json.Unmarshal(doc.Defs["Column"].Properties["identity_inc"], &identityInc))
+		assert.Equal(t, map[string]any{"type": "integer"}, identityInc)
+	})
+
+	t.Run("Table Columns Are Conditional", func(t *testing.T) {
+		table := doc.Defs["Table"]
+		assert.NotContains(t, table.Required, "columns")
+		var alternatives []string
+		for _, alternative := range table.AnyOf {
+			alternatives = append(alternatives, alternative.Required...)
+		}
+		assert.Equal(t, []string{"columns", "as_query", "like"}, alternatives)
+	})
+
+	t.Run("Data Type Enum", func(t *testing.T) {
+		var dataType struct {
+			Enum []gomb.DataType `json:"enum"`
+		}
+		require.NoError(t, json.Unmarshal(doc.Defs["Column"].Properties["data_type"], &dataType))
+		for _, dt := range dataType.Enum {
+			assert.True(t, gomb.IsValidDataType(dt), "unexpected data type %s", dt)
+		}
+		assert.Contains(t, dataType.Enum, gomb.DecimalType)
+	})
+}
+
+func jsonFields(typ reflect.Type) []string {
+	var names []string
+	for i := 0; i < typ.NumField(); i++ {
+		tag, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if tag != "" && tag != "-" {
+			names = append(names, tag)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func propertyNames(properties map[string]json.RawMessage) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
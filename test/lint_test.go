@@ -0,0 +1,255 @@
+package gomb_test
+
+import (
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinter(t *testing.T) {
+	rules := func(findings []gomb.LintFinding) []string {
+		var ids []string
+		for _, finding := range findings {
+			ids = append(ids, finding.Rule)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name      string
+		statement gomb.Statement
+		expected  []string
+	}{
+		{
+			name:      "Drop Column",
+			statement: gomb.NewAlterTable("orders").DropColumn(gomb.NewColumn("legacy")),
+			expected:  []string{"drop_column"},
+		},
+		{
+			name: "Locking Alter Table",
+			statement: gomb.NewAlterTable("orders").
+				AddColumn(gomb.NewColumn("status").SetDataType(gomb.StringType).SetNotNull()).
+				AlterColumn(gomb.NewColumn("total").SetNewDataType(gomb.DecimalType)).
+				SetColumnNotNull(gomb.NewColumn("email")).
+				AddConstraint("orders_total_check", "CHECK (total > 0)").
+				DetachPartition(gomb.NewPartition("orders_2020", "orders")),
+			expected: []string{"add_column_constraint", "change_column_type", "set_not_null", "add_constraint", "detach_partition"},
+		},
+		{
+			name: "Online Alter Table",
+			statement: gomb.NewAlterTable("orders").
+				AddColumn(gomb.NewColumn("note").SetDataType(gomb.StringType).SetDefault("none")).
+				AddConstraintNotValid("orders_total_check", "CHECK (total > 0)").
+				ValidateConstraint("orders_total_check").
+				DetachPartition(gomb.NewPartition("orders_2020", "orders").SetConcurrently()),
+		},
+		{
+			name:      "Rename And Drop Constraint",
+			statement: gomb.NewAlterTable("orders").AlterColumn(gomb.NewColumn("note").SetNewName("notes")).DropConstraint("orders_total_check"),
+			expected:  []string{"rename_column", "drop_constraint"},
+		},
+		{
+			name:      "Drop Tables",
+			statement: gomb.NewDropTable("orders", "invoices").SetCascade(true),
+			expected:  []string{"drop_table", "drop_cascade", "drop_table", "drop_cascade"},
+		},
+		{
+			name:      "Drop Index",
+			statement: gomb.NewDropIndex("idx_orders_total"),
+			expected:  []string{"drop_index"},
+		},
+		{
+			name:      "Drop Index Concurrently",
+			statement: gomb.NewDropIndex("idx_orders_total").SetConcurrently(),
+		},
+		{
+			name:      "Allowed Statement",
+			statement: gomb.AllowLint(gomb.NewDropTable("orders").SetCascade(true), "drop_table"),
+			expected:  []string{"drop_cascade"},
+		},
+		{
+			name: "Column Defaults",
+			statement: gomb.NewAlterTable("orders").
+				AddColumn(gomb.NewColumn("status").SetDataType(gomb.StringType).SetNotNull().SetDefault("pending")).
+				AddColumn(gomb.NewColumn("created_at").SetDataType(gomb.DateTimeType).SetNotNull().SetDefault(gomb.Func("now"))).
+				AddColumn(gomb.NewColumn("token").SetDataType(gomb.StringType).SetDefault(gomb.Func("gen_random_uuid"))),
+			expected: []string{"add_column_constraint"},
+		},
+		{
+			name:      "Truncate",
+			statement: gomb.NewTruncate("orders", "order_items").SetCascade(),
+			expected:  []string{"truncate", "truncate"},
+		},
+		{
+			name:      "Drop Schema",
+			statement: gomb.NewDropSchema("staging", "archive").SetCascade(),
+			expected:  []string{"drop_schema", "drop_schema"},
+		},
+		{
+			name:      "Drop Empty Schema",
+			statement: gomb.NewDropSchema("staging"),
+		},
+		{
+			name:      "Other Statements",
+			statement: gomb.NewDelete("orders"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rules(gomb.NewLinter().Lint(tt.statement)))
+		})
+	}
+
+	t.Run("Finding", func(t *testing.T) {
+		findings := gomb.NewLinter().Lint(gomb.NewDropIndex("idx_a").SetConcurrently(), gomb.NewAlterTable("orders").DropColumn(gomb.NewColumn("legacy")))
+		require.Len(t, findings, 1)
+		assert.Equal(t, gomb.IrreversibleChange, findings[0].Class)
+		assert.Equal(t, gomb.SeverityError, findings[0].Severity)
+		assert.Equal(t, 1, findings[0].Statement)
+		assert.Equal(t, "error drop_column: orders.legacy: dropping a column deletes its data", findings[0].String())
+	})
+
+	t.Run("Severity And Allow List", func(t *testing.T) {
+		linter := gomb.NewLinter().SetSeverity("drop_index", gomb.SeverityError).SetSeverity("drop_cascade", gomb.SeverityOff).Allow("drop_column")
+		require.Empty(t, linter.Errors())
+		findings := linter.Lint(
+			gomb.NewDropIndex("idx_a").SetCascade(),
+			gomb.NewAlterTable("orders").DropColumn(gomb.NewColumn("legacy")),
+		)
+		require.Len(t, findings, 1)
+		assert.Equal(t, "drop_index", findings[0].Rule)
+		assert.Equal(t, gomb.SeverityError, findings[0].Severity)
+
+		linter = gomb.NewLinter().SetSeverity("drop_everything", gomb.SeverityOff).SetSeverity("drop_table", "fatal").Allow("nope")
+		assert.Len(t, linter.Errors(), 3)
+		for _, err := range linter.Errors() {
+			assert.ErrorIs(t, err, gomb.ErrInvalidOption)
+		}
+	})
+
+	t.Run("Target", func(t *testing.T) {
+		linter := gomb.NewLinter().SetTarget(gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}})
+		findings := linter.Lint(gomb.NewAlterTable("orders").AddColumn(gomb.NewColumn("status").SetDataType(gomb.StringType).SetDefault("pending")))
+		require.Len(t, findings, 1)
+		assert.Equal(t, "add_column_constraint", findings[0].Rule)
+
+		findings = gomb.NewLinter().SetTarget(gomb.Target{Dialect: gomb.MySQLDialect}).Lint(gomb.NewDropSchema("staging"))
+		require.Len(t, findings, 1)
+		assert.Equal(t, "drop_schema", findings[0].Rule)
+	})
+
+	t.Run("Allowed Statement Builds", func(t *testing.T) {
+		sql, _, err := gomb.BuildFor(gomb.AllowLint(gomb.NewDropTable("orders"), "drop_table"), gomb.Target{Dialect: gomb.PostgresDialect})
+		require.NoError(t, err)
+		assert.Equal(t, "DROP TABLE IF EXISTS orders", sql)
+	})
+
+	t.Run("Classify Operation", func(t *testing.T) {
+		alter := gomb.NewAlterTable("orders").
+			AddColumn(gomb.NewColumn("note").SetDataType(gomb.StringType)).
+			SetColumnNotNull(gomb.NewColumn("email")).
+			AlterColumn(gomb.NewColumn("note").SetNewName("notes")).
+			DropColumn(gomb.NewColumn("legacy"))
+		var classes []gomb.ChangeClass
+		for _, op := range alter.Operations {
+			classes = append(classes, gomb.ClassifyOperation(op))
+		}
+		assert.Equal(t, []gomb.ChangeClass{gomb.SafeChange, gomb.LockingChange, gomb.DestructiveChange, gomb.IrreversibleChange}, classes)
+	})
+
+	t.Run("Severities", func(t *testing.T) {
+		severity, err := gomb.ParseSeverity(" Warning ")
+		require.NoError(t, err)
+		assert.Equal(t, gomb.SeverityWarning, severity)
+		assert.True(t, gomb.SeverityError.AtLeast(severity))
+		assert.False(t, gomb.SeverityInfo.AtLeast(severity))
+		_, err = gomb.ParseSeverity("fatal")
+		assert.ErrorIs(t, err, gomb.ErrInvalidOption)
+	})
+}
+
+func TestLinter_LintSQL(t *testing.T) {
+	script := `-- orders: gomb:allow is only read from annotations
+ALTER TABLE orders
+  ADD COLUMN status varchar(20) NOT NULL DEFAULT 'a;b',
+  ADD COLUMN token uuid NOT NULL DEFAULT gen_random_uuid(),
+  ADD CONSTRAINT orders_total_check CHECK (total > 0) NOT VALID,
+  ALTER COLUMN total TYPE numeric(12, 2),
+  RENAME COLUMN note TO notes;
+CREATE FUNCTION touch() RETURNS trigger AS $body$ BEGIN DROP TABLE logs; RETURN NEW; END; $body$ LANGUAGE plpgsql;
+DROP INDEX CONCURRENTLY idx_a; DROP INDEX idx_b;
+/* gomb:allow drop_table, drop_cascade */
+DROP TABLE IF EXISTS old_orders CASCADE;
+ALTER TABLE events DETACH PARTITION events_2020; -- gomb:allow detach_partition
+ALTER TABLE "Users" DROP COLUMN legacy, ADD UNIQUE (email), ALTER email SET NOT NULL, OWNER TO admin;
+TRUNCATE TABLE logs, audit RESTART IDENTITY CASCADE;
+DROP SCHEMA IF EXISTS staging CASCADE; DROP SCHEMA empty;
+`
+	findings, err := gomb.NewLinter().LintSQL(script)
+	require.NoError(t, err)
+
+	type finding struct {
+		Line   int
+		Rule   string
+		Object string
+	}
+	var got []finding
+	for _, f := range findings {
+		got = append(got, finding{f.Line, f.Rule, f.Object})
+	}
+	assert.Equal(t, []finding{
+		{2, "add_column_constraint", "token"},
+		{2, "change_column_type", "total"},
+		{2, "rename_column", "note"},
+		{9, "drop_index", "idx_b"},
+		{13, "drop_column", "legacy"},
+		{13, "add_constraint", ""},
+		{13, "set_not_null", "email"},
+		{14, "truncate", ""},
+		{14, "truncate", ""},
+		{15, "drop_schema", "staging"},
+	}, got)
+	assert.Equal(t, `"Users"`, findings[4].Table)
+	assert.Equal(t, "audit", findings[8].Table)
+	assert.Contains(t, findings[8].Message, "CASCADE")
+	assert.Contains(t, findings[1].Message, "numeric(12, 2)")
+
+	t.Run("MySQL", func(t *testing.T) {
+		script := "ALTER TABLE users MODIFY COLUMN age BIGINT, CHANGE name full_name VARCHAR(100), DROP FOREIGN KEY fk_team, DROP INDEX idx_age, ADD INDEX idx_team (team_id), ADD FULLTEXT KEY ft_bio (bio);\n" +
+			"DROP INDEX idx_name ON users;\n" +
+			"ALTER TABLE `shop`.`orders` ADD COLUMN `qty` int NOT NULL, DROP COLUMN `legacy note`;\n" +
+			"DROP DATABASE IF EXISTS `staging`;"
+		findings, err := gomb.NewLinter().SetTarget(gomb.Target{Dialect: gomb.MySQLDialect}).LintSQL(script)
+		require.NoError(t, err)
+		var rules []string
+		for _, f := range findings {
+			rules = append(rules, f.Rule)
+		}
+		assert.Equal(t, []string{"change_column_type", "rename_column", "change_column_type", "drop_constraint", "drop_column", "drop_schema"}, rules,
+			"MySQL drops indexes and adds NOT NULL columns without blocking or failing")
+		assert.Equal(t, "shop.orders", findings[4].Table)
+		assert.Equal(t, "legacy note", findings[4].Object)
+		assert.Equal(t, "staging", findings[5].Object)
+
+		findings, err = gomb.NewLinter().LintSQL("DROP INDEX idx_name ON users; ALTER TABLE orders ADD COLUMN qty int NOT NULL;")
+		require.NoError(t, err)
+		require.Len(t, findings, 2)
+		assert.Equal(t, "drop_index", findings[0].Rule, "PostgreSQL is the default target")
+		assert.Equal(t, "add_column_constraint", findings[1].Rule)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, script := range []string{
+			"DROP TABLE orders; -- gomb:allow drop_tables",
+			"SELECT 'unterminated",
+			"/* unterminated",
+			"DO $$ BEGIN",
+		} {
+			_, err := gomb.NewLinter().LintSQL(script)
+			assert.ErrorIs(t, err, gomb.ErrInvalidOption, script)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package gomb_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionedTable(t *testing.T) {
+	table := gomb.NewTable("events").
+		AddColumn(gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetNotNull()).
+		AddColumn(gomb.NewColumn("created_at").SetDataType(gomb.DateTimeType).SetNotNull()).
+		SetPartitionBy(gomb.RangePartition, "created_at")
+
+	sql, errs := table.ToSQL()
+	require.Empty(t, errs)
+	assert.Equal(t, "CREATE TABLE events (id INTEGER NOT NULL, created_at TIMESTAMP NOT NULL) PARTITION BY RANGE (created_at)", sql)
+
+	t.Run("JSON Round Trip", func(t *testing.T) {
+		data, err := json.Marshal(table)
+		require.NoError(t, err)
+
+		var decoded gomb.Table
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		decodedSQL, errs := decoded.ToSQL()
+		require.Empty(t, errs)
+		assert.Equal(t, sql, decodedSQL)
+	})
+
+	t.Run("Invalid Strategy", func(t *testing.T) {
+		_, errs := gomb.NewTable("events").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.IntegerType)).
+			SetPartitionBy("ROUND_ROBIN", "id").ToSQL()
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], gomb.ErrInvalidPartition)
+	})
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name      string
+		partition *gomb.Partition
+		wantSQL   string
+		wantErr   bool
+	}{
+		{
+			name:      "Range",
+			partition: gomb.NewPartition("events_2024_01", "events").ForRange("2024-01-01", "2024-02-01"),
+			wantSQL:   "CREATE TABLE events_2024_01 PARTITION OF events FOR VALUES FROM ('2024-01-01') TO ('2024-02-01')",
+		},
+		{
+			name:      "Unbounded Multi-Column Range",
+			partition: gomb.NewPartition("metrics_old", "metrics").ForRange([]any{gomb.Raw("MINVALUE"), gomb.Raw("MINVALUE")}, []any{2020, 1}),
+			wantSQL:   "CREATE TABLE metrics_old PARTITION OF metrics FOR VALUES FROM (MINVALUE, MINVALUE) TO (2020, 1)",
+		},
+		{
+			name:      "List",
+			partition: gomb.NewPartition("orders_eu", "orders").SetSchema("sales").ForValues("de", "fr", "it's"),
+			wantSQL:   "CREATE TABLE sales.orders_eu PARTITION OF sales.orders FOR VALUES IN ('de', 'fr', 'it''s')",
+		},
+		{
+			name:      "Hash",
+			partition: gomb.NewPartition("users_p0", "users").ForHash(4, 0),
+			wantSQL:   "CREATE TABLE users_p0 PARTITION OF users FOR VALUES WITH (MODULUS 4, REMAINDER 0)",
+		},
+		{
+			name:      "Default With Sub-Partitions",
+			partition: gomb.NewPartition("events_other", "events").SetDefault().SetPartitionBy(gomb.HashPartition, "id"),
+			wantSQL:   "CREATE TABLE events_other PARTITION OF events DEFAULT PARTITION BY HASH (id)",
+		},
+		{
+			name:      "Missing Bounds",
+			partition: gomb.NewPartition("events_x", "events"),
+			wantErr:   true,
+		},
+		{
+			name:      "Conflicting Bounds",
+			partition: gomb.NewPartition("events_x", "events").SetDefault().ForValues(1),
+			wantErr:   true,
+		},
+		{
+			name:      "Invalid Remainder",
+			partition: gomb.NewPartition("users_p4", "users").ForHash(4, 4),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, err := tt.partition.ToSQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+
+	t.Run("PostgreSQL Only", func(t *testing.T) {
+		_, _, err := gomb.NewPartition("users_p0", "users").ForHash(4, 0).Build(gomb.MySQLDialect)
+		assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+	})
+}
+
+func TestAlterTablePartitions(t *testing.T) {
+	sql, errs := gomb.NewAlterTable("events").
+		AttachPartition(gomb.NewPartition("events_2024_03", "events").ForRange("2024-03-01", "2024-04-01")).
+		ToSQL()
+	require.Empty(t, errs)
+	assert.Equal(t, "ALTER TABLE events ATTACH PARTITION events_2024_03 FOR VALUES FROM ('2024-03-01') TO ('2024-04-01')", sql)
+
+	sql, _, err := gomb.NewAlterTable("events").
+		DetachPartition(gomb.NewPartition("events_2023_01", "events").SetConcurrently()).
+		Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE events DETACH PARTITION events_2023_01 CONCURRENTLY", sql)
+
+	_, _, err = gomb.NewAlterTable("events").DetachPartition(gomb.NewPartition("events_2023_01", "events")).Build(gomb.SQLiteDialect)
+	assert.Error(t, err)
+}
+
+func TestTimeRangePartitions(t *testing.T) {
+	t.Run("Monthly", func(t *testing.T) {
+		start := time.Date(2024, 11, 15, 8, 0, 0, 0, time.UTC)
+		end := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		partitions, err := gomb.TimeRangePartitions("events", start, end, gomb.MonthlyPartitions)
+		require.NoError(t, err)
+		require.Len(t, partitions, 3)
+
+		var names []string
+		for _, p := range partitions {
+			names = append(names, p.Name())
+		}
+		assert.Equal(t, []string{"events_2024_11", "events_2024_12", "events_2025_01"}, names)
+
+		sql, err := partitions[1].ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE TABLE events_2024_12 PARTITION OF events FOR VALUES FROM ('2024-12-01') TO ('2025-01-01')", sql)
+	})
+
+	t.Run("Weekly Starts On Monday", func(t *testing.T) {
+		start := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC) // Wednesday
+		partitions, err := gomb.TimeRangePartitions("logs", start, start.AddDate(0, 0, 7), gomb.WeeklyPartitions)
+		require.NoError(t, err)
+		require.Len(t, partitions, 2)
+		assert.Equal(t, "logs_2024_01_01", partitions[0].Name())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		now := time.Now()
+		_, err := gomb.TimeRangePartitions("logs", now, now, gomb.DailyPartitions)
+		assert.ErrorIs(t, err, gomb.ErrInvalidPartition)
+
+		_, err = gomb.TimeRangePartitions("logs", now, now.Add(time.Hour), gomb.PartitionInterval(0))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,163 @@
+package gomb_test
+
+import (
+	"context"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanner(t *testing.T) {
+	pg16 := gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 16}}
+
+	render := func(t *testing.T, plan *gomb.Plan) []string {
+		t.Helper()
+		var queries []string
+		for _, step := range plan.Steps {
+			sql, _, err := step.Statement.Build(gomb.PostgresDialect)
+			require.NoError(t, err, step.Description)
+			queries = append(queries, sql)
+		}
+		return queries
+	}
+
+	t.Run("Not Null Column With Default", func(t *testing.T) {
+		alter := gomb.NewAlterTable("orders").AddColumn(
+			gomb.NewColumn("status").SetDataType(gomb.StringType).SetLength(20).SetNotNull().SetDefault("pending").SetCheck("(status <> '')"))
+
+		plan, err := gomb.NewPlanner(pg16).SetBatchSize(500).Plan(alter)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Offline)
+		assert.Equal(t, []string{
+			"ALTER TABLE orders ADD COLUMN status VARCHAR(20) DEFAULT 'pending'",
+			"ALTER TABLE orders ADD CONSTRAINT orders_status_not_null CHECK (status IS NOT NULL) NOT VALID",
+			"ALTER TABLE orders VALIDATE CONSTRAINT orders_status_not_null",
+			"ALTER TABLE orders ALTER COLUMN status SET NOT NULL",
+			"ALTER TABLE orders DROP CONSTRAINT orders_status_not_null",
+			"ALTER TABLE orders ADD CONSTRAINT orders_status_check CHECK (status <> '') NOT VALID",
+			"ALTER TABLE orders VALIDATE CONSTRAINT orders_status_check",
+		}, render(t, plan), "PostgreSQL 11+ stores a constant default without a backfill")
+	})
+
+	t.Run("Backfills Volatile Defaults", func(t *testing.T) {
+		alter := gomb.NewAlterTable("orders").AddColumn(
+			gomb.NewColumn("token").SetDataType(gomb.StringType).SetLength(36).SetNotNull().SetDefault(gomb.Func("gen_random_uuid")))
+
+		plan, err := gomb.NewPlanner(pg16).SetBatchSize(500).Plan(alter)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Offline)
+		assert.Equal(t, []string{
+			"ALTER TABLE orders ADD COLUMN token VARCHAR(36)",
+			"ALTER TABLE orders ALTER COLUMN token SET DEFAULT gen_random_uuid()",
+			"ALTER TABLE orders ADD CONSTRAINT orders_token_not_null CHECK (token IS NOT NULL) NOT VALID",
+			"UPDATE orders SET token = DEFAULT WHERE id IN (SELECT id FROM orders WHERE token IS NULL LIMIT 500)",
+			"ALTER TABLE orders VALIDATE CONSTRAINT orders_token_not_null",
+			"ALTER TABLE orders ALTER COLUMN token SET NOT NULL",
+			"ALTER TABLE orders DROP CONSTRAINT orders_token_not_null",
+		}, render(t, plan), "the default is set before the guard so that inserts omitting the column succeed")
+		assert.True(t, plan.Steps[3].Batched)
+	})
+
+	t.Run("Backfills Before PostgreSQL 11", func(t *testing.T) {
+		alter := gomb.NewAlterTable("orders").AddColumn(
+			gomb.NewColumn("status").SetDataType(gomb.StringType).SetLength(20).SetDefault("pending"))
+
+		plan, err := gomb.NewPlanner(gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}}).Plan(alter)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"ALTER TABLE orders ADD COLUMN status VARCHAR(20)",
+			"ALTER TABLE orders ALTER COLUMN status SET DEFAULT 'pending'",
+			"UPDATE orders SET status = DEFAULT WHERE id IN (SELECT id FROM orders WHERE status IS NULL LIMIT 1000)",
+		}, render(t, plan), "a nullable column keeps accepting explicit NULLs during the backfill")
+	})
+
+	t.Run("Unique Foreign Key Column", func(t *testing.T) {
+		alter := gomb.NewAlterTable("orders").AddColumn(
+			gomb.NewColumn("invoice_id").SetDataType(gomb.IntegerType).SetUnique().SetReferences("invoices", "id"))
+
+		plan, err := gomb.NewPlanner(pg16).Plan(alter)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"ALTER TABLE orders ADD COLUMN invoice_id INTEGER",
+			"ALTER TABLE orders ADD CONSTRAINT orders_invoice_id_fkey FOREIGN KEY (invoice_id) REFERENCES invoices(id) NOT VALID",
+			"ALTER TABLE orders VALIDATE CONSTRAINT orders_invoice_id_fkey",
+			"CREATE UNIQUE INDEX CONCURRENTLY orders_invoice_id_key ON orders (invoice_id)",
+			"ALTER TABLE orders ADD CONSTRAINT orders_invoice_id_key UNIQUE USING INDEX orders_invoice_id_key",
+		}, render(t, plan))
+		assert.True(t, plan.Steps[3].NoTransaction)
+	})
+
+	t.Run("Constraints Indexes And Partitions", func(t *testing.T) {
+		alter := gomb.NewAlterTable("events").
+			AddColumn(gomb.NewColumn("note").SetDataType(gomb.StringType)).
+			AddConstraint("events_total_check", "CHECK (total > 0)").
+			DetachPartition(gomb.NewPartition("events_2023", "events")).
+			DropColumn(gomb.NewColumn("legacy"))
+		index := gomb.NewIndex("idx_events_created").OnTable("events").AddColumn("created_at")
+
+		plan, err := gomb.NewPlanner(pg16).Plan(alter, index)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"ALTER TABLE events ADD COLUMN note VARCHAR",
+			"ALTER TABLE events ADD CONSTRAINT events_total_check CHECK (total > 0) NOT VALID",
+			"ALTER TABLE events VALIDATE CONSTRAINT events_total_check",
+			"ALTER TABLE events DETACH PARTITION events_2023 CONCURRENTLY",
+			"ALTER TABLE events DROP COLUMN legacy",
+			"CREATE INDEX CONCURRENTLY idx_events_created ON events (created_at)",
+		}, render(t, plan))
+
+		sql, err := index.ToSQL()
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE INDEX idx_events_created ON events (created_at)", sql, "the planned index is a copy")
+	})
+
+	t.Run("Offline Operations", func(t *testing.T) {
+		alter := gomb.NewAlterTable("users").
+			AlterColumn(gomb.NewColumn("age").SetDataType(gomb.IntegerType).SetNewDataType(gomb.DecimalType)).
+			AddConstraint("users_email_key", "UNIQUE (email)").
+			SetColumnNotNull(gomb.NewColumn("email")).
+			AttachPartition(gomb.NewPartition("users_eu", "users").ForValues("eu"))
+
+		plan, err := gomb.NewPlanner(gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 11}}).Plan(alter)
+		require.NoError(t, err)
+		require.Len(t, plan.Offline, 4)
+		for _, err := range plan.Offline {
+			assert.ErrorIs(t, err, gomb.ErrNotOnline)
+		}
+		assert.Len(t, plan.Steps, 7, "offline operations stay in the plan")
+	})
+
+	t.Run("Exec Repeats Batches", func(t *testing.T) {
+		alter := gomb.NewAlterTable("orders").AddColumn(gomb.NewColumn("flag").SetDataType(gomb.BooleanType).SetDefault(gomb.RawSQL("random() > 0.5")))
+		plan, err := gomb.NewPlanner(pg16).Plan(alter)
+		require.NoError(t, err)
+
+		db, fake := openFakeDB(t)
+		fake.affected = []int64{0, 0, 1000, 1000, 12, 0}
+		require.NoError(t, plan.Exec(context.Background(), db))
+		assert.Len(t, fake.execs, 6)
+	})
+
+	t.Run("PostgreSQL Only", func(t *testing.T) {
+		_, err := gomb.NewPlanner(gomb.Target{Dialect: gomb.MySQLDialect}).Plan(gomb.NewAlterTable("users").DropColumn(gomb.NewColumn("legacy")))
+		assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+	})
+}
+
+func TestAlterTable_Constraints(t *testing.T) {
+	sql, _, err := gomb.NewAlterTable("orders").
+		AddConstraint("orders_total_check", "CHECK (total > 0)").
+		SetColumnDefault(gomb.NewColumn("status").SetDataType(gomb.StringType).SetDefault("new")).
+		DropConstraint("orders_legacy_check").
+		Build(gomb.MySQLDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE orders ADD CONSTRAINT orders_total_check CHECK (total > 0), ALTER COLUMN status SET DEFAULT 'new', DROP CONSTRAINT orders_legacy_check", sql)
+
+	_, _, err = gomb.NewAlterTable("orders").ValidateConstraint("orders_total_check").Build(gomb.MySQLDialect)
+	assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+
+	_, _, err = gomb.NewAlterTable("orders").AddConstraint("orders_total_check", "CHECK (total > 0)").Build(gomb.SQLiteDialect)
+	assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+}
@@ -0,0 +1,157 @@
+package gomb_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement gomb.Statement
+		dialect   gomb.Dialect
+		wantSQL   string
+		wantErr   error
+	}{
+		{
+			name: "Tenant Isolation",
+			statement: gomb.NewPolicy("tenant_isolation", "orders").SetSchema("sales").To("app").
+				SetUsing("tenant_id = current_setting('app.tenant_id')::int").
+				SetWithCheck("tenant_id = current_setting('app.tenant_id')::int"),
+			dialect: gomb.PostgresDialect,
+			wantSQL: "CREATE POLICY tenant_isolation ON sales.orders TO app USING (tenant_id = current_setting('app.tenant_id')::int) WITH CHECK (tenant_id = current_setting('app.tenant_id')::int)",
+		},
+		{
+			name:      "Restrictive Select",
+			statement: gomb.NewPolicy("only_active", "orders").SetRestrictive().SetCommand(gomb.SelectCommand).SetUsingExpr(gomb.Eq("status", "active")),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "CREATE POLICY only_active ON orders AS RESTRICTIVE FOR SELECT USING (status = 'active')",
+		},
+		{
+			name:      "Insert With Using",
+			statement: gomb.NewPolicy("p", "orders").SetCommand(gomb.InsertCommand).SetUsing("true"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   gomb.ErrInvalidOption,
+		},
+		{
+			name:      "Delete With Check",
+			statement: gomb.NewPolicy("p", "orders").SetCommand(gomb.DeleteCommand).SetWithCheck("true"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   gomb.ErrInvalidOption,
+		},
+		{
+			name:      "Invalid Command",
+			statement: gomb.NewPolicy("p", "orders").SetCommand("MERGE"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   gomb.ErrInvalidOption,
+		},
+		{
+			name:      "Missing Name",
+			statement: gomb.NewPolicy("", "orders"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   gomb.ErrRequired,
+		},
+		{
+			name:      "MySQL",
+			statement: gomb.NewPolicy("p", "orders").SetUsing("true"),
+			dialect:   gomb.MySQLDialect,
+			wantErr:   gomb.ErrUnsupportedDialect,
+		},
+		{
+			name:      "Alter Policy",
+			statement: gomb.NewAlterPolicy("tenant_isolation", "orders").To("app", "reporting").SetUsing("tenant_id = 1"),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "ALTER POLICY tenant_isolation ON orders TO app, reporting USING (tenant_id = 1)",
+		},
+		{
+			name:      "Rename Policy",
+			statement: gomb.NewAlterPolicy("tenant_isolation", "orders").RenameTo("tenant_scope"),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "ALTER POLICY tenant_isolation ON orders RENAME TO tenant_scope",
+		},
+		{
+			name:      "Rename Combined With Changes",
+			statement: gomb.NewAlterPolicy("tenant_isolation", "orders").RenameTo("tenant_scope").SetUsing("true"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   gomb.ErrInvalidOption,
+		},
+		{
+			name:      "Alter Without Changes",
+			statement: gomb.NewAlterPolicy("tenant_isolation", "orders"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   gomb.ErrRequired,
+		},
+		{
+			name:      "Drop Policy",
+			statement: gomb.NewDropPolicy("tenant_isolation", "orders").SetSchema("sales").SetIfExists(),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "DROP POLICY IF EXISTS tenant_isolation ON sales.orders",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.statement.Build(tt.dialect)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
+
+func TestAlterTable_RowLevelSecurity(t *testing.T) {
+	sql, _, err := gomb.NewAlterTable("orders").EnableRowLevelSecurity().ForceRowLevelSecurity().Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE orders ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY", sql)
+
+	sql, _, err = gomb.NewAlterTable("orders").NoForceRowLevelSecurity().DisableRowLevelSecurity().Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "ALTER TABLE orders NO FORCE ROW LEVEL SECURITY, DISABLE ROW LEVEL SECURITY", sql)
+
+	_, _, err = gomb.NewAlterTable("orders").EnableRowLevelSecurity().Build(gomb.SQLiteDialect)
+	assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+}
+
+func TestTablePolicies(t *testing.T) {
+	table := gomb.NewTable("orders").
+		AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+		AddColumn(gomb.NewColumn("tenant_id").SetDataType(gomb.IntegerType).SetNotNull()).
+		SetRowSecurity(true).
+		AddPolicy(gomb.TablePolicy{
+			Name:      "tenant_isolation",
+			Roles:     []string{"app"},
+			Using:     "tenant_id = current_setting('app.tenant_id')::int",
+			WithCheck: "tenant_id = current_setting('app.tenant_id')::int",
+		}).
+		AddPolicy(gomb.TablePolicy{Name: "auditors_read", Command: gomb.SelectCommand, Roles: []string{"auditor"}, Using: "true"})
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+	var decoded gomb.Table
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, table.Policies, decoded.Policies)
+
+	db, fake := openFakeDB(t)
+	require.NoError(t, gomb.ExecAll(context.Background(), db, decoded.Statements()...))
+
+	var queries []string
+	for _, exec := range fake.execs {
+		queries = append(queries, exec.query)
+	}
+	assert.Equal(t, []string{
+		"CREATE TABLE orders (id SERIAL PRIMARY KEY, tenant_id INTEGER NOT NULL)",
+		"ALTER TABLE orders ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY",
+		"CREATE POLICY tenant_isolation ON orders TO app USING (tenant_id = current_setting('app.tenant_id')::int) WITH CHECK (tenant_id = current_setting('app.tenant_id')::int)",
+		"CREATE POLICY auditors_read ON orders FOR SELECT TO auditor USING (true)",
+	}, queries)
+
+	assert.Nil(t, gomb.NewTable("plain").RowSecurityStatement())
+}
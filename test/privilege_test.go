@@ -0,0 +1,160 @@
+package gomb_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantRevoke(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement gomb.Statement
+		dialect   gomb.Dialect
+		wantSQL   string
+		wantErr   bool
+	}{
+		{
+			name:      "Table Grant With Grant Option",
+			statement: gomb.NewGrant(gomb.SelectPrivilege, gomb.InsertPrivilege).OnTable("orders", "invoices").SetSchema("sales").To("app", "reporting").SetWithGrantOption(),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "GRANT SELECT, INSERT ON TABLE sales.orders, sales.invoices TO app, reporting WITH GRANT OPTION",
+		},
+		{
+			name:      "Column Grant",
+			statement: gomb.NewGrant(gomb.SelectPrivilege, gomb.UpdatePrivilege).OnTable("users").SetColumns("email", "name").To("support"),
+			dialect:   gomb.MySQLDialect,
+			wantSQL:   "GRANT SELECT (email, name), UPDATE (email, name) ON TABLE users TO support",
+		},
+		{
+			name:      "Sequence Grant",
+			statement: gomb.NewGrant(gomb.UsagePrivilege).OnSequence("users_id_seq").To("app"),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "GRANT USAGE ON SEQUENCE users_id_seq TO app",
+		},
+		{
+			name:      "Schema Grant",
+			statement: gomb.NewGrant(gomb.UsagePrivilege).OnSchema("sales").To("PUBLIC"),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "GRANT USAGE ON SCHEMA sales TO PUBLIC",
+		},
+		{
+			name:      "Revoke Grant Option Cascade",
+			statement: gomb.NewRevoke(gomb.AllPrivileges).OnTable("orders").From("app").SetGrantOptionFor().SetCascade(),
+			dialect:   gomb.PostgresDialect,
+			wantSQL:   "REVOKE GRANT OPTION FOR ALL PRIVILEGES ON TABLE orders FROM app CASCADE",
+		},
+		{
+			name:      "Privilege Not Valid For Object",
+			statement: gomb.NewGrant(gomb.DeletePrivilege).OnSchema("sales").To("app"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "Column Privilege Not Valid",
+			statement: gomb.NewGrant(gomb.DeletePrivilege).OnTable("users").SetColumns("email").To("app"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "Missing Role",
+			statement: gomb.NewGrant(gomb.SelectPrivilege).OnTable("users"),
+			dialect:   gomb.PostgresDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "Schema Grant On MySQL",
+			statement: gomb.NewGrant(gomb.UsagePrivilege).OnSchema("sales").To("app"),
+			dialect:   gomb.MySQLDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "Revoke Cascade On MySQL",
+			statement: gomb.NewRevoke(gomb.SelectPrivilege).OnTable("users").From("app").SetCascade(),
+			dialect:   gomb.MySQLDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "Truncate On MySQL",
+			statement: gomb.NewGrant(gomb.TruncatePrivilege).OnTable("users").To("app"),
+			dialect:   gomb.MySQLDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "Several Tables On MySQL",
+			statement: gomb.NewRevoke(gomb.SelectPrivilege).OnTable("users", "orders").From("app"),
+			dialect:   gomb.MySQLDialect,
+			wantErr:   true,
+		},
+		{
+			name:      "SQLite",
+			statement: gomb.NewGrant(gomb.SelectPrivilege).OnTable("users").To("app"),
+			dialect:   gomb.SQLiteDialect,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.statement.Build(tt.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
+
+func TestRoles(t *testing.T) {
+	sql, err := gomb.NewRole("app").SetLogin().SetPassword("s3cr'et").SetInRole("readers").ToSQL()
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE ROLE app WITH LOGIN PASSWORD 's3cr''et' IN ROLE readers", sql)
+
+	sql, _, err = gomb.NewRole("readers").Build(gomb.MySQLDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE ROLE readers", sql)
+
+	_, _, err = gomb.NewRole("app").SetLogin().Build(gomb.MySQLDialect)
+	assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+
+	sql, err = gomb.NewDropRole("app", "readers").SetIfExists().ToSQL()
+	require.NoError(t, err)
+	assert.Equal(t, "DROP ROLE IF EXISTS app, readers", sql)
+
+	_, err = gomb.NewDropRole().ToSQL()
+	assert.ErrorIs(t, err, gomb.ErrRequired)
+}
+
+func TestTablePrivileges(t *testing.T) {
+	table := gomb.NewTable("orders").
+		AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+		AddIndex(gomb.NewIndex("idx_orders_id").OnTable("orders").AddColumn("id")).
+		AddPrivilege("app", gomb.SelectPrivilege, gomb.InsertPrivilege, gomb.UpdatePrivilege).
+		AddPrivilege("readonly", gomb.SelectPrivilege)
+
+	data, err := json.Marshal(table)
+	require.NoError(t, err)
+	var decoded gomb.Table
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, table.Privileges, decoded.Privileges)
+
+	db, fake := openFakeDB(t)
+	require.NoError(t, gomb.ExecAll(context.Background(), db, decoded.Statements()...))
+
+	var queries []string
+	for _, exec := range fake.execs {
+		queries = append(queries, exec.query)
+	}
+	assert.Equal(t, []string{
+		"CREATE TABLE orders (id SERIAL PRIMARY KEY)",
+		"CREATE INDEX idx_orders_id ON orders (id)",
+		"GRANT SELECT, INSERT, UPDATE ON TABLE orders TO app",
+		"GRANT SELECT ON TABLE orders TO readonly",
+	}, queries)
+}
@@ -0,0 +1,118 @@
+package gomb_test
+
+import (
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *gomb.Trigger
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			name:    "Before Update For Each Row",
+			trigger: gomb.NewTrigger("trg_users_touch", "users").SetBefore(gomb.UpdateEvent).SetForEachRow().SetFunction("touch_users"),
+			wantSQL: "CREATE TRIGGER trg_users_touch BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION touch_users()",
+		},
+		{
+			name: "After Multiple Events With When And Arguments",
+			trigger: gomb.NewTrigger("trg_orders_audit", "orders").SetSchema("sales").SetOrReplace().
+				SetAfter(gomb.InsertEvent, gomb.UpdateEvent).SetUpdateOf("status", "total").SetForEachRow().
+				SetWhenExpr(gomb.Ne("NEW.status", "draft")).SetFunction("audit", "orders", "it's"),
+			wantSQL: "CREATE OR REPLACE TRIGGER trg_orders_audit AFTER INSERT OR UPDATE OF status, total ON sales.orders FOR EACH ROW WHEN (NEW.status <> 'draft') EXECUTE FUNCTION audit('orders', 'it''s')",
+		},
+		{
+			name:    "Instead Of On View",
+			trigger: gomb.NewTrigger("trg_view_insert", "active_users").SetInsteadOf(gomb.InsertEvent).SetForEachRow().SetFunction("insert_user"),
+			wantSQL: "CREATE TRIGGER trg_view_insert INSTEAD OF INSERT ON active_users FOR EACH ROW EXECUTE FUNCTION insert_user()",
+		},
+		{
+			name:    "Statement Level Truncate",
+			trigger: gomb.NewTrigger("trg_truncate", "logs").SetAfter(gomb.TruncateEvent).SetForEachStatement().SetFunction("log_truncate"),
+			wantSQL: "CREATE TRIGGER trg_truncate AFTER TRUNCATE ON logs FOR EACH STATEMENT EXECUTE FUNCTION log_truncate()",
+		},
+		{
+			name:    "Missing Events",
+			trigger: gomb.NewTrigger("trg", "users").SetFunction("fn"),
+			wantErr: true,
+		},
+		{
+			name:    "Missing Function",
+			trigger: gomb.NewTrigger("trg", "users").SetAfter(gomb.InsertEvent),
+			wantErr: true,
+		},
+		{
+			name:    "Instead Of Statement",
+			trigger: gomb.NewTrigger("trg", "v").SetInsteadOf(gomb.InsertEvent).SetFunction("fn"),
+			wantErr: true,
+		},
+		{
+			name:    "Row Level Truncate",
+			trigger: gomb.NewTrigger("trg", "logs").SetAfter(gomb.TruncateEvent).SetForEachRow().SetFunction("fn"),
+			wantErr: true,
+		},
+		{
+			name:    "Update Of Without Update",
+			trigger: gomb.NewTrigger("trg", "users").SetAfter(gomb.InsertEvent).SetUpdateOf("email").SetFunction("fn"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, err := tt.trigger.ToSQL()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+
+	t.Run("PostgreSQL Only", func(t *testing.T) {
+		_, _, err := gomb.NewTrigger("trg", "users").SetAfter(gomb.InsertEvent).SetFunction("fn").Build(gomb.MySQLDialect)
+		assert.ErrorIs(t, err, gomb.ErrUnsupportedDialect)
+	})
+}
+
+func TestDropTrigger(t *testing.T) {
+	sql, err := gomb.NewDropTrigger("trg_users_touch", "users").SetSchema("app").SetIfExists().SetCascade().ToSQL()
+	require.NoError(t, err)
+	assert.Equal(t, "DROP TRIGGER IF EXISTS trg_users_touch ON app.users CASCADE", sql)
+
+	_, err = gomb.NewDropTrigger("trg_users_touch", "").ToSQL()
+	assert.ErrorIs(t, err, gomb.ErrRequired)
+}
+
+func TestTouchUpdatedAt(t *testing.T) {
+	table := gomb.NewTable("users").
+		AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+		AddColumn(gomb.NewColumn("updated_at").SetDataType(gomb.DateTimeType).SetNotNull().SetDefault(gomb.DefaultCurrentTimestamp))
+
+	function, trigger, err := gomb.TouchUpdatedAt(table, "")
+	require.NoError(t, err)
+
+	functionSQL, _, err := function.Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE OR REPLACE FUNCTION touch_users_updated_at() RETURNS TRIGGER LANGUAGE plpgsql AS $$\nBEGIN\n    NEW.updated_at = CURRENT_TIMESTAMP;\n    RETURN NEW;\nEND;\n$$", functionSQL)
+
+	triggerSQL, _, err := trigger.Build(gomb.PostgresDialect)
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE TRIGGER trg_users_updated_at BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION touch_users_updated_at()", triggerSQL)
+
+	_, _, err = gomb.TouchUpdatedAt(table, "modified_at")
+	assert.ErrorIs(t, err, gomb.ErrRequired)
+
+	_, _, err = gomb.TouchUpdatedAt(table, "id")
+	assert.ErrorIs(t, err, gomb.ErrInvalidDataType)
+
+	_, _, err = gomb.TouchUpdatedAt(nil, "")
+	assert.ErrorIs(t, err, gomb.ErrRequired)
+}
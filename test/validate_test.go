@@ -0,0 +1,103 @@
+package gomb_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func problemPaths(t *testing.T, errs []error) map[string]string {
+	t.Helper()
+	paths := map[string]string{}
+	for _, err := range errs {
+		var problem *gomb.ValidationError
+		require.True(t, errors.As(err, &problem), "unexpected error %v", err)
+		paths[problem.Path()] = problem.Message
+	}
+	return paths
+}
+
+func TestValidator(t *testing.T) {
+	users := gomb.NewTable("users").
+		AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+		AddColumn(gomb.NewColumn("email").SetDataType(gomb.StringType).SetLength(255))
+
+	t.Run("Valid Schema", func(t *testing.T) {
+		orders := gomb.NewTable("orders").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+			AddColumn(gomb.NewColumn("user_id").SetDataType(gomb.IntegerType).SetReferences("users", "id"))
+
+		assert.Empty(t, gomb.NewValidator(gomb.PostgresDialect).AddTable(users, orders).Validate())
+	})
+
+	t.Run("Reports Every Problem", func(t *testing.T) {
+		orders := gomb.NewTable("orders").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+			AddColumn(gomb.NewColumn("code").SetDataType(gomb.IntegerType).SetPrimaryKey()).
+			AddColumn(gomb.NewColumn("code").SetDataType(gomb.IntegerType)).
+			AddColumn(gomb.NewColumn("total").SetDataType(gomb.DecimalType).SetPrecision(4).SetScale(6)).
+			AddColumn(gomb.NewColumn("note").SetDataType(gomb.StringType)).
+			AddColumn(gomb.NewColumn("net").SetDataType(gomb.IntegerType).SetGenerated("total - 1").SetDefault(0)).
+			AddColumn(gomb.NewColumn("customer_id").SetDataType(gomb.IntegerType).SetReferences("customers", "id")).
+			AddColumn(gomb.NewColumn("owner_id").SetDataType(gomb.IntegerType).SetReferences("users", "uuid")).
+			AddColumn(gomb.NewColumn("key").SetDataType(gomb.IntegerType)).
+			AddColumn(gomb.NewColumn(strings.Repeat("x", 65)).SetDataType(gomb.IntegerType))
+
+		paths := problemPaths(t, gomb.NewValidator(gomb.MySQLDialect).AddTable(users, orders).Validate())
+		assert.Equal(t, "duplicate column name", paths["orders.code.name"])
+		assert.Contains(t, paths["orders.columns"], "id, code")
+		assert.Contains(t, paths, "orders.total.scale")
+		assert.Contains(t, paths, "orders.note.length")
+		assert.Contains(t, paths, "orders.net.default")
+		assert.Contains(t, paths["orders.customer_id.references"], "unknown table customers")
+		assert.Contains(t, paths["orders.owner_id.references"], "unknown column users.uuid")
+		assert.Contains(t, paths["orders.key.name"], "reserved")
+		assert.Contains(t, paths["orders."+strings.Repeat("x", 65)+".name"], "longer than 64")
+	})
+
+	t.Run("Composite References", func(t *testing.T) {
+		orders := gomb.NewTable("orders").
+			AddColumn(gomb.NewColumn("user_ref").SetDataType(gomb.IntegerType).SetReferences("users", "id, email")).
+			AddColumn(gomb.NewColumn("owner_ref").SetDataType(gomb.IntegerType).SetReferences("users", "id, uuid"))
+
+		paths := problemPaths(t, gomb.NewValidator(gomb.PostgresDialect).AddTable(users, orders).Validate())
+		assert.NotContains(t, paths, "orders.user_ref.references")
+		assert.Contains(t, paths["orders.owner_ref.references"], "unknown column users.uuid")
+	})
+
+	t.Run("Single Table", func(t *testing.T) {
+		orders := gomb.NewTable("orders").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType).SetPrimaryKey()).
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.IntegerType)).
+			AddColumn(gomb.NewColumn("user").SetDataType(gomb.IntegerType).SetReferences("users", "id"))
+
+		paths := problemPaths(t, orders.Validate())
+		assert.Equal(t, "duplicate column name", paths["orders.id.name"])
+		assert.NotContains(t, paths, "orders.user.name", "reserved words are legal once quoted")
+		assert.NotContains(t, paths, "orders.user.references", "other tables are unknown")
+	})
+
+	t.Run("Dialect Rules", func(t *testing.T) {
+		table := gomb.NewTable("user").
+			AddColumn(gomb.NewColumn("note").SetDataType(gomb.StringType))
+
+		assert.Contains(t, problemPaths(t, gomb.NewValidator(gomb.PostgresDialect).AddTable(table).Validate()), "user.name")
+		assert.Empty(t, gomb.NewValidator(gomb.SQLiteDialect).AddTable(table).Validate())
+		assert.Len(t, gomb.NewValidator(gomb.MySQLDialect).AddTable(table).Validate(), 1)
+	})
+
+	t.Run("Identifier Length Override", func(t *testing.T) {
+		table := gomb.NewTable("a_rather_long_table_name").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.SerialType))
+
+		assert.Len(t, gomb.NewValidator(gomb.SQLiteDialect).SetMaxIdentifierLength(10).AddTable(table).Validate(), 1)
+	})
+
+	t.Run("Unsupported Dialect", func(t *testing.T) {
+		assert.Len(t, gomb.NewValidator(gomb.Dialect("oracle")).AddTable(users).Validate(), 1)
+	})
+}
@@ -0,0 +1,215 @@
+package gomb_test
+
+import (
+	"context"
+	"testing"
+
+	gomb "github.com/nandrechetan/gomb/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		input string
+		want  gomb.Version
+	}{
+		{"16", gomb.Version{Major: 16}},
+		{"8.0.36-log", gomb.Version{Major: 8, Minor: 0, Patch: 36}},
+		{"15.4 (Debian 15.4-1.pgdg120+1)", gomb.Version{Major: 15, Minor: 4}},
+		{"3.35.5", gomb.Version{Major: 3, Minor: 35, Patch: 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := gomb.ParseVersion(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := gomb.ParseVersion("latest")
+	assert.ErrorIs(t, err, gomb.ErrInvalidOption)
+
+	assert.True(t, gomb.Version{Major: 8, Minor: 0, Patch: 13}.Less(gomb.Version{Major: 8, Minor: 0, Patch: 16}))
+	assert.Equal(t, "8.0.16", gomb.Version{Major: 8, Patch: 16}.String())
+}
+
+func TestBuildFor(t *testing.T) {
+	pg12 := gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 12}}
+	pg16 := gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 16}}
+	mysql57 := gomb.Target{Dialect: gomb.MySQLDialect, Version: gomb.Version{Major: 5, Minor: 7}}
+	sqlite324 := gomb.Target{Dialect: gomb.SQLiteDialect, Version: gomb.Version{Major: 3, Minor: 24}}
+
+	tests := []struct {
+		name      string
+		statement gomb.Statement
+		target    gomb.Target
+		wantSQL   string
+		wantErr   bool
+	}{
+		{
+			name:      "Compression Dropped Before 14",
+			statement: gomb.NewTable("docs").AddColumn(gomb.NewColumn("body").SetDataType(gomb.StringType).SetCompression("lz4")),
+			target:    pg12,
+			wantSQL:   "CREATE TABLE docs (body VARCHAR)",
+		},
+		{
+			name:      "Compression Kept On 16",
+			statement: gomb.NewTable("docs").AddColumn(gomb.NewColumn("body").SetDataType(gomb.StringType).SetCompression("lz4")),
+			target:    pg16,
+			wantSQL:   "CREATE TABLE docs (body VARCHAR COMPRESSION lz4)",
+		},
+		{
+			name:      "Latest When Version Unset",
+			statement: gomb.NewIndex("idx_events_key").OnTable("events").AddColumn("key").SetUnique().SetNullsNotDistinct(),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect},
+			wantSQL:   "CREATE UNIQUE INDEX idx_events_key ON events (key) NULLS NOT DISTINCT",
+		},
+		{
+			name:      "Nulls Not Distinct Before 15",
+			statement: gomb.NewIndex("idx_events_key").OnTable("events").AddColumn("key").SetUnique().SetNullsNotDistinct(),
+			target:    pg12,
+			wantErr:   true,
+		},
+		{
+			name:      "Expression Key On MySQL 5.7",
+			statement: gomb.NewIndex("idx_users_email").OnTable("users").AddIndexColumn(gomb.NewIndexExpression("lower(email)")),
+			target:    mysql57,
+			wantErr:   true,
+		},
+		{
+			name:      "Check On MySQL 5.7",
+			statement: gomb.NewTable("orders").AddColumn(gomb.NewColumn("total").SetDataType(gomb.IntegerType).SetCheck("(total > 0)")),
+			target:    mysql57,
+			wantErr:   true,
+		},
+		{
+			name:      "Roles On MySQL 5.7",
+			statement: gomb.NewRole("readers"),
+			target:    mysql57,
+			wantErr:   true,
+		},
+		{
+			name:      "Reindex Tablespace Before 14",
+			statement: gomb.NewReindex(gomb.ReindexTable, "orders").SetTablespace("fast_ssd"),
+			target:    pg12,
+			wantErr:   true,
+		},
+		{
+			name:      "Reindex Concurrently On 12",
+			statement: gomb.NewReindex(gomb.ReindexTable, "orders").SetConcurrently(),
+			target:    pg12,
+			wantSQL:   "REINDEX TABLE CONCURRENTLY orders",
+		},
+		{
+			name:      "Or Replace Trigger Before 14",
+			statement: gomb.NewTrigger("trg", "orders").SetOrReplace().SetBefore(gomb.UpdateEvent).SetForEachRow().SetFunction("touch"),
+			target:    pg12,
+			wantErr:   true,
+		},
+		{
+			name:      "Execute Procedure Before 11",
+			statement: gomb.NewTrigger("trg", "orders").SetBefore(gomb.UpdateEvent).SetForEachRow().SetFunction("touch"),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}},
+			wantSQL:   "CREATE TRIGGER trg BEFORE UPDATE ON orders FOR EACH ROW EXECUTE PROCEDURE touch()",
+		},
+		{
+			name:      "Execute Function On 11",
+			statement: gomb.NewTrigger("trg", "orders").SetBefore(gomb.UpdateEvent).SetForEachRow().SetFunction("touch"),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 11}},
+			wantSQL:   "CREATE TRIGGER trg BEFORE UPDATE ON orders FOR EACH ROW EXECUTE FUNCTION touch()",
+		},
+		{
+			name:      "Restrictive Policy Before 10",
+			statement: gomb.NewPolicy("tenant_only", "orders").SetRestrictive().SetUsing("tenant_id = 1"),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 9, Minor: 6}},
+			wantErr:   true,
+		},
+		{
+			name:      "Restrictive Policy On 10",
+			statement: gomb.NewPolicy("tenant_only", "orders").SetRestrictive().SetUsing("tenant_id = 1"),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}},
+			wantSQL:   "CREATE POLICY tenant_only ON orders AS RESTRICTIVE USING (tenant_id = 1)",
+		},
+		{
+			name:      "Attach Index Partition Before 11",
+			statement: gomb.NewAlterIndex("idx_events_key").AttachPartition("idx_events_2024_key"),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}},
+			wantErr:   true,
+		},
+		{
+			name:      "Index Statistics Before 11",
+			statement: gomb.NewAlterIndex("idx_users_lower_email").SetStatistics(1, 500),
+			target:    gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}},
+			wantErr:   true,
+		},
+		{
+			name:      "Index Statistics On 12",
+			statement: gomb.NewAlterIndex("idx_users_lower_email").SetStatistics(1, 500),
+			target:    pg12,
+			wantSQL:   "ALTER INDEX idx_users_lower_email ALTER COLUMN 1 SET STATISTICS 500",
+		},
+		{
+			name:      "No Depends On Extension Before 13",
+			statement: gomb.NewAlterIndex("idx_docs_body").NoDependsOnExtension("pg_trgm"),
+			target:    pg12,
+			wantErr:   true,
+		},
+		{
+			name:      "Detach Concurrently Before 14",
+			statement: gomb.NewAlterTable("events").DetachPartition(gomb.NewPartition("events_2023", "events").SetConcurrently()),
+			target:    pg12,
+			wantErr:   true,
+		},
+		{
+			name:      "Drop Column On SQLite 3.24",
+			statement: gomb.NewAlterTable("users").DropColumn(gomb.NewColumn("legacy")),
+			target:    sqlite324,
+			wantErr:   true,
+		},
+		{
+			name:      "Unversioned Statement",
+			statement: gomb.NewDropTable("users"),
+			target:    pg12,
+			wantSQL:   "DROP TABLE IF EXISTS users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := gomb.BuildFor(tt.statement, tt.target)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, gomb.ErrUnsupportedVersion)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
+
+func TestTargetVersion(t *testing.T) {
+	t.Run("Exec Uses Connection Target", func(t *testing.T) {
+		db, fake := openFakeDB(t)
+		conn := gomb.WithTarget(db, gomb.Target{Dialect: gomb.PostgresDialect, Version: gomb.Version{Major: 10}})
+		assert.Equal(t, gomb.Version{Major: 10}, gomb.TargetOf(conn).Version)
+
+		err := gomb.ExecAll(context.Background(), conn,
+			gomb.NewIndex("idx_orders_customer").OnTable("orders").AddColumn("customer_id"),
+			gomb.NewIndex("idx_orders_covering").OnTable("orders").AddColumn("customer_id").AddIncludeColumn("total"))
+		assert.ErrorIs(t, err, gomb.ErrUnsupportedVersion)
+		assert.Len(t, fake.execs, 1)
+	})
+
+	t.Run("Validator", func(t *testing.T) {
+		table := gomb.NewTable("events").
+			AddColumn(gomb.NewColumn("id").SetDataType(gomb.IntegerType).SetPrimaryKey()).
+			AddColumn(gomb.NewColumn("total").SetDataType(gomb.IntegerType).SetGenerated("id * 2")).
+			SetPartitionBy(gomb.HashPartition, "id")
+
+		errs := gomb.NewValidator(gomb.PostgresDialect).SetVersion(gomb.Version{Major: 10}).AddTable(table).Validate()
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], gomb.ErrUnsupportedVersion)
+		assert.Empty(t, gomb.NewValidator(gomb.PostgresDialect).SetVersion(gomb.Version{Major: 12}).AddTable(table).Validate())
+	})
+}